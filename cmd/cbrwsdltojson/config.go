@@ -2,23 +2,77 @@ package main
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/skolzkyi/cbrwsdltojson/internal/app"
+	cleaner "github.com/skolzkyi/cbrwsdltojson/internal/cleaner"
+	customsoap "github.com/skolzkyi/cbrwsdltojson/internal/customsoap"
+	rediscache "github.com/skolzkyi/cbrwsdltojson/internal/rediscache"
+	warmup "github.com/skolzkyi/cbrwsdltojson/internal/warmup"
+)
+
+// Backends of cache.
+const (
+	CacheBackendMemory = "memory"
+	CacheBackendDisk   = "disk"
+	CacheBackendRedis  = "redis"
+)
+
+var (
+	ErrBadMethodDuration   = errors.New("bad method duration, expected MethodName=duration")
+	ErrBadMethodNumber     = errors.New("bad method number, expected MethodName=number")
+	ErrUnknownCacheBackend = errors.New("unknown cache backend")
 )
 
 type Config struct {
-	permittedRequest      map[string]struct{} `mapstructure:"PERMITTED_REQUESTS"`
-	Logger                LoggerConf          `mapstructure:"Logger"`
-	ServerShutdownTimeout time.Duration       `mapstructure:"SERVER_SHUTDOWN_TIMEOUT"`
-	CBRWSDLTimeout        time.Duration       `mapstructure:"CBR_WSDL_TIMEOUT"`
-	InfoExpirTime         time.Duration       `mapstructure:"INFO_EXPIR_TIME"`
-	InfoClearTimeDelta    time.Duration       `mapstructure:"INFO_CLEAR_TIME_DELTA"`
-	address               string              `mapstructure:"ADDRESS"`
-	port                  string              `mapstructure:"PORT"`
-	cbrWSDLAddress        string              `mapstructure:"CBR_WSDL_ADDRESS"`
-	loggingOn             bool                `mapstructure:"LOGGING_ON"`
+	permittedRequest        map[string]struct{} `mapstructure:"PERMITTED_REQUESTS"`
+	prohibitedRequest       map[string]struct{} `mapstructure:"PROHIBITED_REQUESTS"`
+	Logger                  LoggerConf          `mapstructure:"Logger"`
+	ServerShutdownTimeout   time.Duration       `mapstructure:"SERVER_SHUTDOWN_TIMEOUT"`
+	CBRWSDLTimeout          time.Duration       `mapstructure:"CBR_WSDL_TIMEOUT"`
+	InfoExpirTime           time.Duration       `mapstructure:"INFO_EXPIR_TIME"`
+	HistoricalInfoExpirTime time.Duration       `mapstructure:"HISTORICAL_INFO_EXPIR_TIME"`
+	InfoClearTimeDelta      time.Duration       `mapstructure:"INFO_CLEAR_TIME_DELTA"`
+	infoClearJitter         float64             `mapstructure:"INFO_CLEAR_JITTER"`
+	LatestDateCheckInterval time.Duration       `mapstructure:"LATEST_DATE_CHECK_INTERVAL"`
+	StaleWhileRevalidate    time.Duration       `mapstructure:"STALE_WHILE_REVALIDATE"`
+	StaleIfError            time.Duration       `mapstructure:"STALE_IF_ERROR"`
+	CBRRetryBaseDelay       time.Duration       `mapstructure:"CBR_RETRY_BASE_DELAY"`
+	CBRRetryMaxDelay        time.Duration       `mapstructure:"CBR_RETRY_MAX_DELAY"`
+	CBRBreakerOpenTimeout   time.Duration       `mapstructure:"CBR_BREAKER_OPEN_TIMEOUT"`
+	RedisTimeout            time.Duration       `mapstructure:"REDIS_TIMEOUT"`
+	cbrRetryOn              []string            `mapstructure:"CBR_RETRY_ON"`
+	cacheMaxMemory          int64               `mapstructure:"CACHE_MAX_MEMORY"`
+	cbrRetryMaxAttempts     int                 `mapstructure:"CBR_RETRY_MAX_ATTEMPTS"`
+	cbrBreakerFailures      int                 `mapstructure:"CBR_BREAKER_FAILURE_THRESHOLD"`
+	cbrBreakerHalfOpenCalls int                 `mapstructure:"CBR_BREAKER_HALF_OPEN_MAX_CALLS"`
+	redisDB                 int                 `mapstructure:"REDIS_DB"`
+	address                 string              `mapstructure:"ADDRESS"`
+	port                    string              `mapstructure:"PORT"`
+	cbrWSDLAddress          string              `mapstructure:"CBR_WSDL_ADDRESS"`
+	apiKeyPoliciesFile      string              `mapstructure:"API_KEY_POLICIES_FILE"`
+	adminToken              string              `mapstructure:"ADMIN_TOKEN"`
+	warmUpFile              string              `mapstructure:"WARMUP_FILE"`
+	cacheTTLMethods         string              `mapstructure:"CACHE_TTL_METHODS"`
+	cacheBypassMethods      string              `mapstructure:"CACHE_BYPASS_METHODS"`
+	cacheMaxEntriesMethods  string              `mapstructure:"CACHE_MAX_ENTRIES_METHODS"`
+	cachePoliciesFile       string              `mapstructure:"CACHE_POLICIES_FILE"`
+	warmUpTimezone          string              `mapstructure:"WARMUP_TIMEZONE"`
+	cacheBackend            string              `mapstructure:"CACHE_BACKEND"`
+	cacheDir                string              `mapstructure:"CACHE_DIR"`
+	redisAddress            string              `mapstructure:"REDIS_ADDRESS"`
+	redisPassword           string              `mapstructure:"REDIS_PASSWORD"`
+	redisKeyPrefix          string              `mapstructure:"REDIS_KEY_PREFIX"`
+	apiKeyRequired          bool                `mapstructure:"API_KEY_REQUIRED"`
+	loggingOn               bool                `mapstructure:"LOGGING_ON"`
+	staleWhileRevalidateBy  map[string]time.Duration
+	staleIfErrorBy          map[string]time.Duration
+	cachePolicies           map[string]app.CachePolicy
 }
 
 type LoggerConf struct {
@@ -40,12 +94,44 @@ func (config *Config) Init(path string) error {
 	viper.SetDefault("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second)
 	viper.SetDefault("CBR_WSDL_TIMEOUT", 5*time.Second)
 	viper.SetDefault("INFO_EXPIR_TIME", 12*time.Hour)
+	viper.SetDefault("HISTORICAL_INFO_EXPIR_TIME", 720*time.Hour)
 	viper.SetDefault("INFO_CLEAR_TIME_DELTA", 1*time.Hour)
+	viper.SetDefault("INFO_CLEAR_JITTER", 0.1)
+	viper.SetDefault("LATEST_DATE_CHECK_INTERVAL", 5*time.Minute)
+	viper.SetDefault("CACHE_MAX_MEMORY", "256MB")
+	viper.SetDefault("CACHE_BACKEND", CacheBackendMemory)
+	viper.SetDefault("CACHE_DIR", "/var/lib/cbrwsdltojson/cache")
+	viper.SetDefault("REDIS_ADDRESS", "redis:6379")
+	viper.SetDefault("REDIS_PASSWORD", "")
+	viper.SetDefault("REDIS_DB", 0)
+	viper.SetDefault("REDIS_KEY_PREFIX", "cbrwsdltojson")
+	viper.SetDefault("REDIS_TIMEOUT", 1*time.Second)
+	viper.SetDefault("STALE_WHILE_REVALIDATE", 0)
+	viper.SetDefault("STALE_IF_ERROR", 0)
+	viper.SetDefault("STALE_WHILE_REVALIDATE_METHODS", "")
+	viper.SetDefault("STALE_IF_ERROR_METHODS", "")
+	viper.SetDefault("CACHE_TTL_METHODS", "")
+	viper.SetDefault("CACHE_BYPASS_METHODS", "")
+	viper.SetDefault("CACHE_MAX_ENTRIES_METHODS", "")
+	viper.SetDefault("CACHE_POLICIES_FILE", "")
+	viper.SetDefault("CBR_RETRY_MAX_ATTEMPTS", 3)
+	viper.SetDefault("CBR_RETRY_BASE_DELAY", 100*time.Millisecond)
+	viper.SetDefault("CBR_RETRY_MAX_DELAY", 1*time.Second)
+	viper.SetDefault("CBR_RETRY_ON", "network timeout http5xx")
+	viper.SetDefault("CBR_BREAKER_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("CBR_BREAKER_OPEN_TIMEOUT", 30*time.Second)
+	viper.SetDefault("CBR_BREAKER_HALF_OPEN_MAX_CALLS", 1)
 	viper.SetDefault("LOGGING_ON", true)
 	viper.SetDefault("CBR_WSDL_ADDRESS", "http://www.cbr.ru/DailyInfoWebServ/DailyInfo.asmx")
 	viper.SetDefault("DATE_TIME_RESPONSE_LAYOUT", "2006-01-02 15:04:05")
 	viper.SetDefault("DATE_TIME_REQUEST_LAYOUT", "2006-01-02 15:04:05")
 	viper.SetDefault("PERMITTED_REQUESTS", "")
+	viper.SetDefault("PROHIBITED_REQUESTS", "")
+	viper.SetDefault("API_KEY_POLICIES_FILE", "")
+	viper.SetDefault("API_KEY_REQUIRED", true)
+	viper.SetDefault("ADMIN_TOKEN", "")
+	viper.SetDefault("WARMUP_FILE", "")
+	viper.SetDefault("WARMUP_TIMEZONE", "Europe/Moscow")
 
 	viper.SetDefault("LOG_LEVEL", "debug")
 
@@ -66,19 +152,115 @@ func (config *Config) Init(path string) error {
 	config.ServerShutdownTimeout = viper.GetDuration("SERVER_SHUTDOWN_TIMEOUT")
 	config.CBRWSDLTimeout = viper.GetDuration("CBR_WSDL_TIMEOUT")
 	config.InfoExpirTime = viper.GetDuration("INFO_EXPIR_TIME")
+	config.HistoricalInfoExpirTime = viper.GetDuration("HISTORICAL_INFO_EXPIR_TIME")
 	config.InfoClearTimeDelta = viper.GetDuration("INFO_CLEAR_TIME_DELTA")
+	config.infoClearJitter = viper.GetFloat64("INFO_CLEAR_JITTER")
+	config.LatestDateCheckInterval = viper.GetDuration("LATEST_DATE_CHECK_INTERVAL")
+	config.cacheMaxMemory = int64(viper.GetSizeInBytes("CACHE_MAX_MEMORY"))
+	config.cacheBackend = viper.GetString("CACHE_BACKEND")
+	config.cacheDir = viper.GetString("CACHE_DIR")
+	config.redisAddress = viper.GetString("REDIS_ADDRESS")
+	config.redisPassword = viper.GetString("REDIS_PASSWORD")
+	config.redisDB = viper.GetInt("REDIS_DB")
+	config.redisKeyPrefix = viper.GetString("REDIS_KEY_PREFIX")
+	config.RedisTimeout = viper.GetDuration("REDIS_TIMEOUT")
+	config.StaleWhileRevalidate = viper.GetDuration("STALE_WHILE_REVALIDATE")
+	config.StaleIfError = viper.GetDuration("STALE_IF_ERROR")
+	config.cbrRetryMaxAttempts = viper.GetInt("CBR_RETRY_MAX_ATTEMPTS")
+	config.CBRRetryBaseDelay = viper.GetDuration("CBR_RETRY_BASE_DELAY")
+	config.CBRRetryMaxDelay = viper.GetDuration("CBR_RETRY_MAX_DELAY")
+	config.cbrRetryOn = strings.Fields(viper.GetString("CBR_RETRY_ON"))
+	config.cbrBreakerFailures = viper.GetInt("CBR_BREAKER_FAILURE_THRESHOLD")
+	config.CBRBreakerOpenTimeout = viper.GetDuration("CBR_BREAKER_OPEN_TIMEOUT")
+	config.cbrBreakerHalfOpenCalls = viper.GetInt("CBR_BREAKER_HALF_OPEN_MAX_CALLS")
 	config.loggingOn = viper.GetBool("LOGGING_ON")
 	config.cbrWSDLAddress = viper.GetString("CBR_WSDL_ADDRESS")
-	tempPermReq := viper.GetString("PERMITTED_REQUESTS")
-	permittedRequests := make(map[string]struct{})
-	if tempPermReq != "" {
-		tempPermReqSl := strings.Split(tempPermReq, " ")
-		for _, curPR := range tempPermReqSl {
-			permittedRequests[strings.TrimSpace(curPR)] = struct{}{}
+	config.apiKeyPoliciesFile = viper.GetString("API_KEY_POLICIES_FILE")
+	config.apiKeyRequired = viper.GetBool("API_KEY_REQUIRED")
+	config.adminToken = viper.GetString("ADMIN_TOKEN")
+	config.warmUpFile = viper.GetString("WARMUP_FILE")
+	config.cacheTTLMethods = viper.GetString("CACHE_TTL_METHODS")
+	config.cacheBypassMethods = viper.GetString("CACHE_BYPASS_METHODS")
+	config.cacheMaxEntriesMethods = viper.GetString("CACHE_MAX_ENTRIES_METHODS")
+	config.cachePoliciesFile = viper.GetString("CACHE_POLICIES_FILE")
+	config.warmUpTimezone = viper.GetString("WARMUP_TIMEZONE")
+	config.permittedRequest = requestsListToMap(viper.GetString("PERMITTED_REQUESTS"))
+	config.prohibitedRequest = requestsListToMap(viper.GetString("PROHIBITED_REQUESTS"))
+	// all fields are parsed before errors are returned, so the config is complete with any error
+	var errs []error
+	config.staleWhileRevalidateBy, err = methodDurationsListToMap(viper.GetString("STALE_WHILE_REVALIDATE_METHODS"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("STALE_WHILE_REVALIDATE_METHODS: %w", err))
+	}
+	config.staleIfErrorBy, err = methodDurationsListToMap(viper.GetString("STALE_IF_ERROR_METHODS"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("STALE_IF_ERROR_METHODS: %w", err))
+	}
+	switch config.cacheBackend {
+	case CacheBackendMemory, CacheBackendDisk, CacheBackendRedis:
+	default:
+		errs = append(errs, fmt.Errorf("CACHE_BACKEND: %w: %s", ErrUnknownCacheBackend, config.cacheBackend))
+	}
+	return errors.Join(errs...)
+}
+
+// redactedSecret replaces not void secrets in the printed config.
+const redactedSecret = "***"
+
+// configFields is Config without String method.
+type configFields Config
+
+// String prints the config without secrets.
+func (config Config) String() string { //nolint: gocritic
+	if config.redisPassword != "" {
+		config.redisPassword = redactedSecret
+	}
+	if config.adminToken != "" {
+		config.adminToken = redactedSecret
+	}
+	return fmt.Sprintf("%v", configFields(config))
+}
+
+func requestsListToMap(requestsList string) map[string]struct{} {
+	requests := make(map[string]struct{})
+	for _, curRequest := range strings.Fields(requestsList) {
+		requests[curRequest] = struct{}{}
+	}
+	return requests
+}
+
+// methodDurationsListToMap parses list like "KeyRateXML=1h GetCursOnDateXML=30m".
+func methodDurationsListToMap(list string) (map[string]time.Duration, error) {
+	durations := make(map[string]time.Duration)
+	for _, item := range strings.Fields(list) {
+		methodName, durationStr, found := strings.Cut(item, "=")
+		if !found || methodName == "" {
+			return nil, fmt.Errorf("%w: %q", ErrBadMethodDuration, item)
 		}
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrBadMethodDuration, item)
+		}
+		durations[methodName] = duration
 	}
-	config.permittedRequest = permittedRequests
-	return nil
+	return durations, nil
+}
+
+// methodNumbersListToMap parses list like "GetCursOnDateXML=100 KeyRateXML=10".
+func methodNumbersListToMap(list string) (map[string]int, error) {
+	numbers := make(map[string]int)
+	for _, item := range strings.Fields(list) {
+		methodName, numberStr, found := strings.Cut(item, "=")
+		if !found || methodName == "" {
+			return nil, fmt.Errorf("%w: %q", ErrBadMethodNumber, item)
+		}
+		number, err := strconv.Atoi(numberStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrBadMethodNumber, item)
+		}
+		numbers[methodName] = number
+	}
+	return numbers, nil
 }
 
 func (config *Config) GetServerURL() string {
@@ -105,10 +287,105 @@ func (config *Config) GetInfoExpirTime() time.Duration {
 	return config.InfoExpirTime
 }
 
+func (config *Config) GetHistoricalInfoExpirTime() time.Duration {
+	return config.HistoricalInfoExpirTime
+}
+
 func (config *Config) GetInfoClearTimeDelta() time.Duration {
 	return config.InfoClearTimeDelta
 }
 
+func (config *Config) GetLatestDateCheckInterval() time.Duration {
+	return config.LatestDateCheckInterval
+}
+
+// GetCacheMaxMemory returns the memory budget of cache in bytes, zero is no budget.
+func (config *Config) GetCacheMaxMemory() int64 {
+	return config.cacheMaxMemory
+}
+
+func (config *Config) GetCacheBackend() string {
+	return config.cacheBackend
+}
+
+func (config *Config) GetCacheDir() string {
+	return config.cacheDir
+}
+
+func (config *Config) GetRedisOptions() rediscache.Options {
+	return rediscache.Options{
+		Address:  config.redisAddress,
+		Password: config.redisPassword,
+		DB:       config.redisDB,
+		Timeout:  config.RedisTimeout,
+	}
+}
+
+func (config *Config) GetRedisKeyPrefix() string {
+	return config.redisKeyPrefix
+}
+
+func (config *Config) GetStaleWhileRevalidate(methodName string) time.Duration {
+	if duration, ok := config.staleWhileRevalidateBy[methodName]; ok {
+		return duration
+	}
+	return config.StaleWhileRevalidate
+}
+
+func (config *Config) GetStaleIfError(methodName string) time.Duration {
+	if duration, ok := config.staleIfErrorBy[methodName]; ok {
+		return duration
+	}
+	return config.StaleIfError
+}
+
+// GetCachePolicy returns the cache policy of the method, which is loaded by LoadCachePolicies.
+func (config *Config) GetCachePolicy(methodName string) app.CachePolicy {
+	return config.cachePolicies[methodName]
+}
+
+// LoadCachePolicies builds cache policies of methods by CACHE_TTL_METHODS, CACHE_BYPASS_METHODS, CACHE_MAX_ENTRIES_METHODS
+// and CACHE_POLICIES_FILE and validates them, policy of the method in the file replaces its settings in env.
+func (config *Config) LoadCachePolicies() error {
+	policies := make(map[string]app.CachePolicy)
+	ttls, err := methodDurationsListToMap(config.cacheTTLMethods)
+	if err != nil {
+		return fmt.Errorf("CACHE_TTL_METHODS: %w", err)
+	}
+	for methodName, ttl := range ttls {
+		policy := policies[methodName]
+		policy.TTL = ttl
+		policies[methodName] = policy
+	}
+	for methodName := range requestsListToMap(config.cacheBypassMethods) {
+		policy := policies[methodName]
+		policy.Bypass = true
+		policies[methodName] = policy
+	}
+	maxEntries, err := methodNumbersListToMap(config.cacheMaxEntriesMethods)
+	if err != nil {
+		return fmt.Errorf("CACHE_MAX_ENTRIES_METHODS: %w", err)
+	}
+	for methodName, methodMaxEntries := range maxEntries {
+		policy := policies[methodName]
+		policy.MaxEntries = methodMaxEntries
+		policies[methodName] = policy
+	}
+	filePolicies, err := app.LoadCachePolicies(config.cachePoliciesFile)
+	if err != nil {
+		return fmt.Errorf("CACHE_POLICIES_FILE: %w", err)
+	}
+	for methodName, policy := range filePolicies {
+		policies[methodName] = policy
+	}
+	err = app.ValidateCachePolicies(policies)
+	if err != nil {
+		return err
+	}
+	config.cachePolicies = policies
+	return nil
+}
+
 func (config *Config) GetCBRWSDLAddress() string {
 	return config.cbrWSDLAddress
 }
@@ -117,6 +394,65 @@ func (config *Config) GetLoggingOn() bool {
 	return config.loggingOn
 }
 
-func (config *Config) GetPermittedRequests() map[string]struct{} {
-	return config.permittedRequest
+func (config *Config) GetRetryPolicy() customsoap.RetryPolicy {
+	return customsoap.RetryPolicy{
+		MaxAttempts: config.cbrRetryMaxAttempts,
+		BaseDelay:   config.CBRRetryBaseDelay,
+		MaxDelay:    config.CBRRetryMaxDelay,
+		RetryOn:     config.cbrRetryOn,
+	}
+}
+
+func (config *Config) GetCacheCleanerConfig() cleaner.Config {
+	return cleaner.Config{
+		Interval: config.InfoClearTimeDelta,
+		Jitter:   config.infoClearJitter,
+	}
+}
+
+func (config *Config) GetCircuitBreakerConfig() customsoap.CircuitBreakerConfig {
+	return customsoap.CircuitBreakerConfig{
+		FailureThreshold: config.cbrBreakerFailures,
+		OpenTimeout:      config.CBRBreakerOpenTimeout,
+		HalfOpenMaxCalls: config.cbrBreakerHalfOpenCalls,
+	}
+}
+
+// GetAdminToken returns the token of the admin API, void token disables it.
+func (config *Config) GetAdminToken() string {
+	return config.adminToken
+}
+
+// GetWarmUpJobs returns jobs of WARMUP_FILE and the location of their schedules by WARMUP_TIMEZONE.
+func (config *Config) GetWarmUpJobs() ([]warmup.Job, *time.Location, error) {
+	location, err := time.LoadLocation(config.warmUpTimezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("WARMUP_TIMEZONE: %w", err)
+	}
+	jobs, err := warmup.LoadJobs(config.warmUpFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("WARMUP_FILE: %w", err)
+	}
+	return jobs, location, nil
+}
+
+// GetAccessPolicies builds access policies by PERMITTED_REQUESTS, PROHIBITED_REQUESTS, API_KEY_POLICIES_FILE and API_KEY_REQUIRED.
+func (config *Config) GetAccessPolicies() (*app.AccessPolicies, error) {
+	apiKeyPolicies, err := app.LoadAPIKeyPolicies(config.apiKeyPoliciesFile)
+	if err != nil {
+		return nil, err
+	}
+	defaultPolicy := app.AccessPolicy{
+		Allow: mapKeys(config.permittedRequest),
+		Deny:  mapKeys(config.prohibitedRequest),
+	}
+	return app.NewAccessPolicies(defaultPolicy, apiKeyPolicies, config.apiKeyRequired)
+}
+
+func mapKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
 }
@@ -8,20 +8,31 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"reflect"
 	"syscall"
+	_ "time/tzdata"
 
 	"github.com/skolzkyi/cbrwsdltojson/internal/app"
 	"github.com/skolzkyi/cbrwsdltojson/internal/logger"
 
+	cleaner "github.com/skolzkyi/cbrwsdltojson/internal/cleaner"
 	customsoap "github.com/skolzkyi/cbrwsdltojson/internal/customsoap"
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+	diskcache "github.com/skolzkyi/cbrwsdltojson/internal/diskcache"
 	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+	rediscache "github.com/skolzkyi/cbrwsdltojson/internal/rediscache"
 	internalhttp "github.com/skolzkyi/cbrwsdltojson/internal/server/http"
+	warmup "github.com/skolzkyi/cbrwsdltojson/internal/warmup"
 )
 
-var configFilePath string
+var (
+	configFilePath    string
+	cacheSnapshotPath string
+)
 
 func init() {
 	flag.StringVar(&configFilePath, "config", "./configs/", "Path to config.env")
+	flag.StringVar(&cacheSnapshotPath, "cache-snapshot", "", "Path to cache snapshot (JSON or gzip) to preload on start")
 }
 
 func main() {
@@ -33,20 +44,53 @@ func main() {
 	}
 
 	config := NewConfig()
-	err := config.Init(configFilePath)
-	if err != nil {
-		fmt.Println(err)
-	}
+	configErr := config.Init(configFilePath)
 	fmt.Println("config: ", config)
 	log, err := logger.New(config.Logger.Level, config.GetLoggingOn())
 	if err != nil {
 		fmt.Println(err)
 	}
+	if configErr != nil {
+		log.Fatal("config error: " + configErr.Error())
+	}
 	log.Info("servAddr: " + config.GetAddress())
-	soapSender := customsoap.New(log, &config)
-	appMemcache := memcache.New()
+	retrySender, err := customsoap.NewRetrySender(log, customsoap.New(log, &config), config.GetRetryPolicy(), customsoap.CreateRetryMetrics())
+	if err != nil {
+		log.Fatal("retry policy error: " + err.Error())
+	}
+	soapSender, err := customsoap.NewCircuitBreaker(log, retrySender, config.GetCircuitBreakerConfig(), customsoap.CreateCircuitBreakerMetrics())
+	if err != nil {
+		log.Fatal("circuit breaker config error: " + err.Error())
+	}
+	err = config.LoadCachePolicies()
+	if err != nil {
+		log.Fatal("cache policies error: " + err.Error())
+	}
+	appMemcache := newAppMemCache(log, &config)
 	appMemcache.Init()
-	cbrwsdltojson := app.New(log, &config, soapSender, appMemcache, config.GetPermittedRequests())
+	accessPolicies, err := config.GetAccessPolicies()
+	if err != nil {
+		log.Fatal("access policies error: " + err.Error())
+	}
+	cbrwsdltojson := app.New(log, &config, soapSender, appMemcache, accessPolicies)
+	if cacheSnapshotPath != "" {
+		err = importCacheSnapshot(cbrwsdltojson, cacheSnapshotPath)
+		if err != nil {
+			log.Fatal("cache snapshot error: " + err.Error())
+		}
+	}
+	cacheCleaner, err := cleaner.New(log, cbrwsdltojson, config.GetCacheCleanerConfig(), cleaner.CreateMetrics())
+	if err != nil {
+		log.Fatal("cache cleaner config error: " + err.Error())
+	}
+	warmUpJobs, warmUpLocation, err := config.GetWarmUpJobs()
+	if err != nil {
+		log.Fatal("warm-up config error: " + err.Error())
+	}
+	warmUpScheduler, err := warmup.NewScheduler(log, cbrwsdltojson, warmUpJobs, warmUpLocation, config.GetCBRWSDLTimeout(), warmup.CreateMetrics())
+	if err != nil {
+		log.Fatal("warm-up config error: " + err.Error())
+	}
 
 	server := internalhttp.NewServer(log, cbrwsdltojson, &config)
 
@@ -54,7 +98,15 @@ func main() {
 		syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 	defer cancel()
 
+	warmUpScheduler.Start(ctx)
+	err = cacheCleaner.Start(ctx)
+	if err != nil {
+		log.Fatal("cache cleaner start error: " + err.Error())
+	}
+
+	serverStopped := make(chan struct{})
 	go func() {
+		defer close(serverStopped)
 		<-ctx.Done()
 
 		ctx, cancel := context.WithTimeout(context.Background(), config.GetServerShutdownTimeout())
@@ -65,9 +117,52 @@ func main() {
 		}
 	}()
 
-	if err := server.Start(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	err = server.Start(ctx)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Error("failed to start http server: " + err.Error())
 		cancel()
+	}
+	// the cleaner and the cache are stopped after the server, so requests in flight can use the cache
+	<-serverStopped
+	cacheCleaner.Stop()
+	closeAppMemCache(appMemcache)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		os.Exit(1) //nolint:gocritic
 	}
 }
+
+func importCacheSnapshot(cbrwsdltojson *app.App, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = cbrwsdltojson.ImportCacheSnapshot(file)
+	return err
+}
+
+// closeAppMemCache closes the log of the disk cache and connections of the redis cache.
+func closeAppMemCache(appMemcache app.AppMemCache) {
+	if closer, ok := appMemcache.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+// newAppMemCache creates cache of the CACHE_BACKEND, disk and redis caches store results of all registered methods.
+func newAppMemCache(log *logger.LogWrap, config *Config) app.AppMemCache {
+	memory := memcache.NewWithMemoryBudget(config.GetCacheMaxMemory(), memcache.CreateMetrics())
+	descriptors := datastructures.DefaultMethodDescriptors()
+	resultTypes := make([]reflect.Type, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		resultTypes = append(resultTypes, reflect.TypeOf(descriptor.NewResult()).Elem())
+	}
+	switch config.GetCacheBackend() {
+	case CacheBackendDisk:
+		return diskcache.New(log, config.GetCacheDir(), memory, resultTypes)
+	case CacheBackendRedis:
+		client := rediscache.NewClient(config.GetRedisOptions())
+		return rediscache.New(log, client, config.GetRedisKeyPrefix(), memory, resultTypes)
+	default:
+		return memory
+	}
+}
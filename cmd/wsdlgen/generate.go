@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+const generatedHeader = "// Code generated by wsdlgen from wsdl/DailyInfo.wsdl, wsdl/DailyInfoResults.xsd and wsdl/methods.json. DO NOT EDIT.\n\n"
+
+type generatedFile struct {
+	Name    string
+	Content []byte
+}
+
+func generate(m model, pkg string) ([]generatedFile, error) {
+	requests, err := generateRequests(m, pkg)
+	if err != nil {
+		return nil, err
+	}
+	results, err := generateResults(m, pkg)
+	if err != nil {
+		return nil, err
+	}
+	descriptors, err := generateDescriptors(m, pkg)
+	if err != nil {
+		return nil, err
+	}
+	return []generatedFile{
+		{Name: "requests_gen.go", Content: requests},
+		{Name: "results_gen.go", Content: results},
+		{Name: "descriptors_gen.go", Content: descriptors},
+	}, nil
+}
+
+func formatFile(name string, buf *bytes.Buffer) ([]byte, error) {
+	content, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return content, nil
+}
+
+func writeImports(buf *bytes.Buffer, imports ...string) {
+	if len(imports) == 0 {
+		return
+	}
+	buf.WriteString("import (\n")
+	for _, imp := range imports {
+		fmt.Fprintf(buf, "\t%q\n", imp)
+	}
+	buf.WriteString(")\n\n")
+}
+
+type requestParam struct {
+	GoName  string
+	XMLName string
+	XSDType string
+}
+
+func generateRequests(m model, pkg string) ([]byte, error) {
+	var body bytes.Buffer
+	usesTime, usesStrings := false, false
+	generated := make(map[string]struct{})
+	for _, method := range m.Methods {
+		if _, ok := generated[method.GoType]; ok {
+			continue
+		}
+		generated[method.GoType] = struct{}{}
+		element := m.Requests[method.SOAPMethod]
+
+		params := make([]requestParam, 0, len(element.Params))
+		fmt.Fprintf(&body, "type %s struct {\n", method.GoType)
+		fmt.Fprintf(&body, "\tXMLName xml.Name `xml:%q json:\"-\"`\n", method.SOAPMethod)
+		body.WriteString("\tXMLNs string `xml:\"xmlns,attr\" json:\"-\"`\n")
+		for _, param := range element.Params {
+			p := requestParam{GoName: camelName(param.Name), XMLName: param.Name, XSDType: localName(param.Type)}
+			goType := "string"
+			switch p.XSDType {
+			case "dateTime", "string":
+			case "boolean":
+				goType = "bool"
+			case "int":
+				goType = "int32"
+			default:
+				return nil, fmt.Errorf("%w: %s.%s %s", ErrUnsupportedXSDType, method.SOAPMethod, param.Name, param.Type)
+			}
+			fmt.Fprintf(&body, "\t%s %s `xml:%q json:%q`\n", p.GoName, goType, p.XMLName, p.GoName)
+			params = append(params, p)
+		}
+		body.WriteString("}\n\n")
+
+		fmt.Fprintf(&body, "func (data *%s) Init() {\n\tdata.XMLNs = cbrNamespace\n}\n\n", method.GoType)
+
+		validate, t, s := validateBody(params)
+		usesTime = usesTime || t
+		usesStrings = usesStrings || s
+		fmt.Fprintf(&body, "func (data *%s) Validate() error {\n%s\treturn nil\n}\n\n", method.GoType, validate)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	imports := []string{"encoding/xml"}
+	if usesStrings {
+		imports = append(imports, "strings")
+	}
+	if usesTime {
+		imports = append(imports, "time")
+	}
+	writeImports(&buf, imports...)
+	buf.Write(body.Bytes())
+	return formatFile("requests_gen.go", &buf)
+}
+
+// validateBody checks dates by input layout, order of the FromDate/ToDate pair and void strings.
+func validateBody(params []requestParam) (string, bool, bool) {
+	var body strings.Builder
+	usesTime, usesStrings := false, false
+	var fromDate, toDate string
+	for _, p := range params {
+		if p.XSDType != "dateTime" {
+			continue
+		}
+		switch strings.ToLower(p.GoName) {
+		case "fromdate":
+			fromDate = p.GoName
+		case "todate":
+			toDate = p.GoName
+		}
+	}
+	pairDates := fromDate != "" && toDate != ""
+
+	errDeclared := false
+	for _, p := range params {
+		if p.XSDType != "dateTime" {
+			continue
+		}
+		usesTime = true
+		target := "_"
+		if pairDates && (p.GoName == fromDate || p.GoName == toDate) {
+			target = dateVarName(p.GoName)
+		}
+		assign := ":="
+		if errDeclared && target == "_" {
+			assign = "="
+		}
+		errDeclared = true
+		fmt.Fprintf(&body, "\t%s, err %s time.Parse(inputDTLayout, data.%s)\n\tif err != nil {\n\t\treturn ErrBadRawData\n\t}\n", target, assign, p.GoName)
+	}
+	if pairDates {
+		fmt.Fprintf(&body, "\tif %s.After(%s) {\n\t\treturn ErrBadInputDateData\n\t}\n", dateVarName(fromDate), dateVarName(toDate))
+	}
+	for _, p := range params {
+		if p.XSDType != "string" {
+			continue
+		}
+		usesStrings = true
+		errName := "ErrBadRawData"
+		if p.GoName == "ValutaCode" {
+			errName = "ErrBadValutaCode"
+		}
+		fmt.Fprintf(&body, "\tif strings.TrimSpace(data.%s) == \"\" {\n\t\treturn %s\n\t}\n", p.GoName, errName)
+	}
+	return body.String(), usesTime, usesStrings
+}
+
+// dateVarName returns name of the parsed date variable: FromDate -> fromDateDate.
+func dateVarName(goName string) string {
+	return strings.ToLower(goName[:1]) + goName[1:] + "Date"
+}
+
+func generateResults(m model, pkg string) ([]byte, error) {
+	nodes := make(map[string][]string)
+	for _, method := range m.Methods {
+		if !contains(nodes[method.ResultType], method.StartNode) {
+			nodes[method.ResultType] = append(nodes[method.ResultType], method.StartNode)
+		}
+	}
+
+	var body bytes.Buffer
+	usesTime := false
+	for _, complexType := range m.ResultTypes {
+		t, err := writeResultType(&body, complexType, nodes[complexType.Name])
+		if err != nil {
+			return nil, err
+		}
+		usesTime = usesTime || t
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	if usesTime {
+		writeImports(&buf, "time")
+	}
+	buf.Write(body.Bytes())
+	return formatFile("results_gen.go", &buf)
+}
+
+// writeResultType writes struct of the complex type: attributes, then elements, then char data.
+func writeResultType(body *bytes.Buffer, complexType xsdComplexType, resultNodes []string) (bool, error) {
+	usesTime := false
+	fmt.Fprintf(body, "type %s struct {\n", complexType.Name)
+	switch len(resultNodes) {
+	case 0:
+	case 1:
+		fmt.Fprintf(body, "\t// %s node\n", resultNodes[0])
+	default:
+		fmt.Fprintf(body, "\t// %s nodes\n", strings.Join(resultNodes, ", "))
+	}
+	attributes := complexType.Attributes
+	if complexType.SimpleContent != nil {
+		attributes = complexType.SimpleContent.Attributes
+	}
+	for _, node := range attributes {
+		goType, ok := goTypeOfXSD(node.Type)
+		if !ok {
+			return false, fmt.Errorf("%w: %s.%s %s", ErrUnsupportedXSDType, complexType.Name, node.Name, node.Type)
+		}
+		usesTime = usesTime || goType == "time.Time"
+		fmt.Fprintf(body, "\t%s %s `xml:\"%s,attr\" json:%q`\n", nodeGoName(node), goType, node.Name, nodeJSONName(node))
+	}
+	for _, node := range complexType.Elements {
+		goType, ok := goTypeOfXSD(node.Type)
+		if !ok {
+			if !strings.HasPrefix(node.Type, "tns:") {
+				return false, fmt.Errorf("%w: %s.%s %s", ErrUnsupportedXSDType, complexType.Name, node.Name, node.Type)
+			}
+			goType = localName(node.Type)
+		}
+		usesTime = usesTime || goType == "time.Time"
+		if node.MaxOccurs == "unbounded" {
+			goType = "[]" + goType
+		}
+		fmt.Fprintf(body, "\t%s %s `xml:%q json:%q`\n", nodeGoName(node), goType, node.Name, nodeJSONName(node))
+	}
+	if complexType.SimpleContent != nil {
+		goType, ok := goTypeOfXSD(complexType.SimpleContent.Base)
+		if !ok {
+			return false, fmt.Errorf("%w: %s %s", ErrUnsupportedXSDType, complexType.Name, complexType.SimpleContent.Base)
+		}
+		usesTime = usesTime || goType == "time.Time"
+		fmt.Fprintf(body, "\t%s %s `xml:\",chardata\" json:%q`\n", complexType.SimpleContent.GoName, goType, complexType.SimpleContent.JSON)
+	}
+	body.WriteString("}\n\n")
+	return usesTime, nil
+}
+
+func nodeGoName(node xsdNode) string {
+	if node.GoName != "" {
+		return node.GoName
+	}
+	return exportedName(node.Name)
+}
+
+func nodeJSONName(node xsdNode) string {
+	if node.JSON != "" {
+		return node.JSON
+	}
+	return node.Name
+}
+
+func generateDescriptors(m model, pkg string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("func DefaultMethodDescriptors() []MethodDescriptor {\n\treturn []MethodDescriptor{\n")
+	for _, method := range m.Methods {
+		buf.WriteString("\t\t{\n")
+		fmt.Fprintf(&buf, "\t\t\tName: %q,\n", method.Name)
+		fmt.Fprintf(&buf, "\t\t\tSOAPMethod: %q,\n", method.SOAPMethod)
+		fmt.Fprintf(&buf, "\t\t\tStartNodeName: %q,\n", method.StartNode)
+		fmt.Fprintf(&buf, "\t\t\tNewRequest: func() RequestData { return &%s{} },\n", method.GoType)
+		fmt.Fprintf(&buf, "\t\t\tNewResult: func() interface{} { return &%s{} },\n", method.ResultType)
+		if method.PostProcess != "" {
+			fmt.Fprintf(&buf, "\t\t\tPostProcess: %s,\n", method.PostProcess)
+		}
+		if method.LatestDateMethod != "" {
+			fmt.Fprintf(&buf, "\t\t\tLatestDateMethod: %q,\n", method.LatestDateMethod)
+		}
+		if len(m.Requests[method.SOAPMethod].Params) == 0 {
+			buf.WriteString("\t\t\tWithoutParams: true,\n")
+		}
+		if method.NotCached {
+			buf.WriteString("\t\t\tNotCached: true,\n")
+		}
+		if method.LatestDateSource {
+			buf.WriteString("\t\t\tLatestDateSource: true,\n")
+		}
+		if method.RangeDateField != "" {
+			fmt.Fprintf(&buf, "\t\t\tRangeDateField: %q,\n", method.RangeDateField)
+		}
+		if method.RangeDescending {
+			buf.WriteString("\t\t\tRangeDescending: true,\n")
+		}
+		buf.WriteString("\t\t},\n")
+	}
+	buf.WriteString("\t}\n}\n")
+	return formatFile("descriptors_gen.go", &buf)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
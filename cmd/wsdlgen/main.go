@@ -0,0 +1,57 @@
+// Command wsdlgen generates request structures, result structures and method descriptors
+// of the datastructures package from the DailyInfo WSDL snapshot.
+//
+// It is called by go generate in internal/datastructures:
+//
+//	go generate ./internal/datastructures/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var (
+	wsdlPath    string
+	xsdPath     string
+	methodsPath string
+	outDir      string
+	pkgName     string
+)
+
+func init() {
+	flag.StringVar(&wsdlPath, "wsdl", "wsdl/DailyInfo.wsdl", "Path to DailyInfo WSDL snapshot")
+	flag.StringVar(&xsdPath, "xsd", "wsdl/DailyInfoResults.xsd", "Path to XSD of result nodes")
+	flag.StringVar(&methodsPath, "methods", "wsdl/methods.json", "Path to methods config")
+	flag.StringVar(&outDir, "out", ".", "Output directory")
+	flag.StringVar(&pkgName, "pkg", "datastructures", "Package name of generated files")
+}
+
+func main() {
+	flag.Parse()
+	err := run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "wsdlgen: "+err.Error())
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	m, err := loadModel(wsdlPath, xsdPath, methodsPath)
+	if err != nil {
+		return err
+	}
+	files, err := generate(m, pkgName)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		err = os.WriteFile(filepath.Join(outDir, file.Name), file.Content, 0o644) //nolint: gosec
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
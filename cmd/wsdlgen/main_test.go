@@ -0,0 +1,77 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const datastructuresDir = "../../internal/datastructures"
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	m, err := loadModel(
+		filepath.Join(datastructuresDir, "wsdl/DailyInfo.wsdl"),
+		filepath.Join(datastructuresDir, "wsdl/DailyInfoResults.xsd"),
+		filepath.Join(datastructuresDir, "wsdl/methods.json"),
+	)
+	require.NoError(t, err)
+	files, err := generate(m, "datastructures")
+	require.NoError(t, err)
+	for _, file := range files {
+		committed, err := os.ReadFile(filepath.Join(datastructuresDir, file.Name))
+		require.NoError(t, err)
+		require.Equal(t, string(committed), string(file.Content), file.Name+" is outdated, run go generate ./internal/datastructures/")
+	}
+}
+
+func TestLoadModelErrors(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	wsdl := filepath.Join(dir, "test.wsdl")
+	xsd := filepath.Join(dir, "test.xsd")
+	methods := filepath.Join(dir, "methods.json")
+	require.NoError(t, os.WriteFile(wsdl, []byte(`<definitions><types><schema><element name="KeyRateXML"></element></schema></types><portType><operation name="KeyRateXML"/></portType></definitions>`), 0o600))
+	require.NoError(t, os.WriteFile(xsd, []byte(`<schema><complexType name="KeyRateXMLResult"/></schema>`), 0o600))
+
+	testCases := []struct {
+		name    string
+		methods string
+		err     error
+	}{
+		{name: "MethodNotInWSDL", methods: `[{"soapMethod":"DVXML","startNode":"DV_base"}]`, err: ErrMethodNotInWSDL},
+		{name: "ResultNotInXSD", methods: `[{"soapMethod":"KeyRateXML","resultType":"KR","startNode":"KeyRate"}]`, err: ErrResultNotInXSD},
+		{name: "VoidStartNode", methods: `[{"soapMethod":"KeyRateXML"}]`, err: ErrVoidStartNode},
+		{name: "Positive", methods: `[{"soapMethod":"KeyRateXML","startNode":"KeyRate"}]`, err: nil},
+	}
+	for _, testCase := range testCases {
+		require.NoError(t, os.WriteFile(methods, []byte(testCase.methods), 0o600))
+		_, err := loadModel(wsdl, xsd, methods)
+		require.ErrorIs(t, err, testCase.err, testCase.name)
+	}
+}
+
+func TestValidateBody(t *testing.T) {
+	t.Parallel()
+	body, usesTime, usesStrings := validateBody([]requestParam{
+		{GoName: "FromDate", XSDType: "dateTime"},
+		{GoName: "ToDate", XSDType: "dateTime"},
+		{GoName: "ValutaCode", XSDType: "string"},
+	})
+	require.Equal(t, true, usesTime)
+	require.Equal(t, true, usesStrings)
+	require.Contains(t, body, "fromDateDate, err := time.Parse(inputDTLayout, data.FromDate)")
+	require.Contains(t, body, "toDateDate, err := time.Parse(inputDTLayout, data.ToDate)")
+	require.Contains(t, body, "if fromDateDate.After(toDateDate) {")
+	require.Contains(t, body, "return ErrBadValutaCode")
+
+	body, usesTime, usesStrings = validateBody([]requestParam{{GoName: "OnDate", XSDType: "dateTime"}})
+	require.Equal(t, true, usesTime)
+	require.Equal(t, false, usesStrings)
+	require.Equal(t, "\t_, err := time.Parse(inputDTLayout, data.OnDate)\n\tif err != nil {\n\t\treturn ErrBadRawData\n\t}\n", body)
+
+	body, usesTime, _ = validateBody([]requestParam{{GoName: "Seld", XSDType: "boolean"}})
+	require.Equal(t, false, usesTime)
+	require.Equal(t, "", body)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrMethodNotInWSDL    = errors.New("method is not declared in WSDL")
+	ErrResultNotInXSD     = errors.New("result type is not declared in XSD")
+	ErrUnsupportedXSDType = errors.New("unsupported XSD type")
+	ErrVoidStartNode      = errors.New("void start node")
+)
+
+// WSDL subset: request elements of the types section and operations of the port types.
+type wsdlDefinitions struct {
+	Elements   []wsdlElement   `xml:"types>schema>element"`
+	Operations []wsdlOperation `xml:"portType>operation"`
+}
+
+type wsdlElement struct {
+	Name   string          `xml:"name,attr"`
+	Params []wsdlParameter `xml:"complexType>sequence>element"`
+}
+
+type wsdlParameter struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type wsdlOperation struct {
+	Name string `xml:"name,attr"`
+}
+
+// XSD subset: named complex types with a sequence of elements, attributes or simple content.
+type xsdSchema struct {
+	ComplexTypes []xsdComplexType `xml:"complexType"`
+}
+
+type xsdComplexType struct {
+	Name          string            `xml:"name,attr"`
+	Elements      []xsdNode         `xml:"sequence>element"`
+	Attributes    []xsdNode         `xml:"attribute"`
+	SimpleContent *xsdSimpleContent `xml:"simpleContent>extension"`
+}
+
+type xsdSimpleContent struct {
+	Base       string    `xml:"base,attr"`
+	GoName     string    `xml:"https://github.com/skolzkyi/cbrwsdltojson/wsdlgen goName,attr"`
+	JSON       string    `xml:"https://github.com/skolzkyi/cbrwsdltojson/wsdlgen json,attr"`
+	Attributes []xsdNode `xml:"attribute"`
+}
+
+type xsdNode struct {
+	Name      string `xml:"name,attr"`
+	Type      string `xml:"type,attr"`
+	MaxOccurs string `xml:"maxOccurs,attr"`
+	GoName    string `xml:"https://github.com/skolzkyi/cbrwsdltojson/wsdlgen goName,attr"`
+	JSON      string `xml:"https://github.com/skolzkyi/cbrwsdltojson/wsdlgen json,attr"`
+}
+
+// methodConfig is the part of method description, which is not in WSDL: routing, decoding and caching.
+type methodConfig struct {
+	SOAPMethod       string `json:"soapMethod"`
+	Name             string `json:"name"`
+	GoType           string `json:"goType"`
+	ResultType       string `json:"resultType"`
+	StartNode        string `json:"startNode"`
+	PostProcess      string `json:"postProcess"`
+	LatestDateMethod string `json:"latestDateMethod"`
+	RangeDateField   string `json:"rangeDateField"`
+	// RangeDescending marks methods, which CBR WS answers with elements from the latest day to the earliest one.
+	RangeDescending  bool `json:"rangeDescending"`
+	NotCached        bool `json:"notCached"`
+	LatestDateSource bool `json:"latestDateSource"`
+	// Comment explains the method settings, it is not used in generation.
+	Comment string `json:"comment"`
+}
+
+type model struct {
+	Methods     []methodConfig
+	Requests    map[string]wsdlElement
+	ResultTypes []xsdComplexType
+}
+
+func readXMLFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(data, v)
+}
+
+func loadModel(wsdlPath string, xsdPath string, methodsPath string) (model, error) {
+	var m model
+	var definitions wsdlDefinitions
+	err := readXMLFile(wsdlPath, &definitions)
+	if err != nil {
+		return m, fmt.Errorf("%s: %w", wsdlPath, err)
+	}
+	var schema xsdSchema
+	err = readXMLFile(xsdPath, &schema)
+	if err != nil {
+		return m, fmt.Errorf("%s: %w", xsdPath, err)
+	}
+	data, err := os.ReadFile(methodsPath)
+	if err != nil {
+		return m, err
+	}
+	err = json.Unmarshal(data, &m.Methods)
+	if err != nil {
+		return m, fmt.Errorf("%s: %w", methodsPath, err)
+	}
+
+	operations := make(map[string]struct{}, len(definitions.Operations))
+	for _, operation := range definitions.Operations {
+		operations[operation.Name] = struct{}{}
+	}
+	m.Requests = make(map[string]wsdlElement, len(definitions.Elements))
+	for _, element := range definitions.Elements {
+		if _, ok := operations[element.Name]; ok {
+			m.Requests[element.Name] = element
+		}
+	}
+	m.ResultTypes = schema.ComplexTypes
+	resultTypes := make(map[string]struct{}, len(schema.ComplexTypes))
+	for _, complexType := range schema.ComplexTypes {
+		resultTypes[complexType.Name] = struct{}{}
+	}
+
+	for i := range m.Methods {
+		method := &m.Methods[i]
+		if method.Name == "" {
+			method.Name = method.SOAPMethod
+		}
+		if method.GoType == "" {
+			method.GoType = exportedName(method.SOAPMethod)
+		}
+		if method.ResultType == "" {
+			method.ResultType = method.GoType + "Result"
+		}
+		if _, ok := m.Requests[method.SOAPMethod]; !ok {
+			return m, fmt.Errorf("%w: %s", ErrMethodNotInWSDL, method.SOAPMethod)
+		}
+		if _, ok := resultTypes[method.ResultType]; !ok {
+			return m, fmt.Errorf("%w: %s", ErrResultNotInXSD, method.ResultType)
+		}
+		if method.StartNode == "" {
+			return m, fmt.Errorf("%w: %s", ErrVoidStartNode, method.SOAPMethod)
+		}
+	}
+	return m, nil
+}
+
+// exportedName upper the first letter: mrrfXML -> MrrfXML, num_code -> Num_code.
+func exportedName(name string) string {
+	runes := []rune(name)
+	if len(runes) == 0 {
+		return name
+	}
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// camelName removes underscores: On_date -> OnDate.
+func camelName(name string) string {
+	parts := strings.Split(name, "_")
+	for i := range parts {
+		parts[i] = exportedName(parts[i])
+	}
+	return strings.Join(parts, "")
+}
+
+func localName(qualifiedName string) string {
+	_, name, found := strings.Cut(qualifiedName, ":")
+	if !found {
+		return qualifiedName
+	}
+	return name
+}
+
+func goTypeOfXSD(xsdType string) (string, bool) {
+	switch localName(xsdType) {
+	case "string", "decimal":
+		return "string", true
+	case "dateTime":
+		return "time.Time", true
+	case "int":
+		return "int32", true
+	case "long":
+		return "int64", true
+	case "integer":
+		return "int", true
+	case "boolean":
+		return "bool", true
+	default:
+		return "", false
+	}
+}
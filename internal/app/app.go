@@ -6,29 +6,61 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"fmt"
+	"io"
+	"reflect"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
-	helpers "github.com/skolzkyi/cbrwsdltojson/helpers"
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
 	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
 )
 
+// latestDateLayout is the layout of dates returned by latest date methods of CBR WS.
+const latestDateLayout = "2006-01-02T15:04:05"
+
 var (
 	ErrAssertionAfterXMLDecoding  = errors.New("assertion error after XML decoding")
 	ErrAssertionAfterGetCacheData = errors.New("assertion error after get cached data")
 	ErrAssertionOfInputData       = errors.New("assertion input data error")
 	ErrMethodProhibited           = errors.New("method prohibited")
 	ErrContextWSReqExpired        = errors.New("context of request to CBR WS expired")
+	ErrMethodNotFound             = errors.New("method not found")
+	ErrStartNodeNotFound          = errors.New("start node not found in CBR WS response")
+)
+
+// Cache statuses of the response.
+const (
+	CacheHit   = "HIT"
+	CacheMiss  = "MISS"
+	CacheStale = "STALE"
+)
+
+// Reasons of the stale response.
+const (
+	StaleReasonRevalidate = "revalidate"
+	StaleReasonError      = "error"
 )
 
+// ResponseCacheInfo describes the source of the response: CBR WS, fresh or stale cached data.
+type ResponseCacheInfo struct {
+	Status      string
+	StaleReason string
+	Age         time.Duration
+}
+
 type App struct {
-	logger            Logger
-	config            Config
-	soapSender        SoapRequestSender
-	Appmemcache       AppMemCache
-	permittedRequests PermittedReqSyncMap
+	logger        Logger
+	config        Config
+	soapSender    SoapRequestSender
+	Appmemcache   AppMemCache
+	policies      *AccessPolicies
+	latestDates   LatestDateSyncMap
+	methods       *datastructures.MethodRegistry
+	requests      *requestGroup
+	cacheRequests *cacheRequestCounter
 }
 
 type Logger interface {
@@ -47,10 +79,14 @@ type Config interface {
 	GetServerShutdownTimeout() time.Duration
 	GetCBRWSDLTimeout() time.Duration
 	GetInfoExpirTime() time.Duration
+	GetHistoricalInfoExpirTime() time.Duration
 	GetInfoClearTimeDelta() time.Duration
+	GetLatestDateCheckInterval() time.Duration
+	GetStaleWhileRevalidate(methodName string) time.Duration
+	GetStaleIfError(methodName string) time.Duration
+	GetCachePolicy(methodName string) CachePolicy
 	GetCBRWSDLAddress() string
 	GetLoggingOn() bool
-	GetPermittedRequests() map[string]struct{}
 }
 
 type SoapRequestSender interface {
@@ -60,59 +96,76 @@ type SoapRequestSender interface {
 type AppMemCache interface { //nolint: revive
 	Init()
 	AddOrUpdatePayloadInCache(tag string, payload interface{}) bool
+	AddOrUpdateHistoricalPayloadInCache(tag string, payload interface{}) bool
+	RestoreCacheDataInCache(tag string, info memcache.CacheInfo) bool
 	RemovePayloadInCache(tag string)
-	RemoveAllPayloadInCacheByTimeStamp(controlTime time.Time)
+	RemoveAllPayloadInCacheByTimeStamp(controlTime time.Time) int
+	RemoveHistoricalPayloadInCacheByTimeStamp(controlTime time.Time) int
 	GetCacheDataInCache(tag string) (memcache.CacheInfo, bool)
+	PeekCacheDataInCache(tag string) (memcache.CacheInfo, bool)
+	GetAllCacheEntries() []memcache.EntryInfo
+	Snapshot() map[string]memcache.CacheInfo
 	PrintAllCacheKeys()
 }
 
-type PermittedReqSyncMap struct {
-	mu                sync.RWMutex
-	permittedRequests map[string]struct{}
+type LatestDateInfo struct {
+	LatestDate    string
+	CheckDTStamp  time.Time
+	ChangeDTStamp time.Time
 }
 
-func NewPermittedReqSyncMap() PermittedReqSyncMap {
-	return PermittedReqSyncMap{}
+type LatestDateSyncMap struct {
+	mu          sync.RWMutex
+	latestDates map[string]LatestDateInfo
 }
 
-func (prsm *PermittedReqSyncMap) Init(initMap map[string]struct{}) {
-	prsm.mu.Lock()
-	defer prsm.mu.Unlock()
-	prsm.permittedRequests = make(map[string]struct{})
-	if len(initMap) > 0 {
-		prsm.permittedRequests = initMap
-	}
+func NewLatestDateSyncMap() LatestDateSyncMap {
+	return LatestDateSyncMap{}
 }
 
-func (prsm *PermittedReqSyncMap) AddPermittedRequest(permittedRequest string) {
-	prsm.mu.Lock()
-	defer prsm.mu.Unlock()
-	prsm.permittedRequests[permittedRequest] = struct{}{}
+func (ldsm *LatestDateSyncMap) Init() {
+	ldsm.mu.Lock()
+	defer ldsm.mu.Unlock()
+	ldsm.latestDates = make(map[string]LatestDateInfo)
 }
 
-func (prsm *PermittedReqSyncMap) IsPermittedRequestInMap(permittedRequest string) bool {
-	prsm.mu.RLock()
-	defer prsm.mu.RUnlock()
-	_, ok := prsm.permittedRequests[permittedRequest]
-	return ok
+// UpdateLatestDate returns true if the latest date was changed since the previous check.
+func (ldsm *LatestDateSyncMap) UpdateLatestDate(SOAPMethod string, latestDate string) bool { //nolint: gocritic
+	ldsm.mu.Lock()
+	defer ldsm.mu.Unlock()
+	now := time.Now()
+	info, ok := ldsm.latestDates[SOAPMethod]
+	changed := ok && info.LatestDate != latestDate
+	info.LatestDate = latestDate
+	info.CheckDTStamp = now
+	if changed {
+		info.ChangeDTStamp = now
+	}
+	ldsm.latestDates[SOAPMethod] = info
+	return changed
 }
 
-func (prsm *PermittedReqSyncMap) PermittedRequestMapLength() int {
-	prsm.mu.RLock()
-	defer prsm.mu.RUnlock()
-	length := len(prsm.permittedRequests)
-	return length
+func (ldsm *LatestDateSyncMap) GetLatestDateInfo(SOAPMethod string) (LatestDateInfo, bool) { //nolint: gocritic
+	ldsm.mu.RLock()
+	defer ldsm.mu.RUnlock()
+	info, ok := ldsm.latestDates[SOAPMethod]
+	return info, ok
 }
 
-func New(logger Logger, config Config, sender SoapRequestSender, memcache AppMemCache, permReqMap map[string]struct{}) *App {
+// New creates App, nil policies permit all methods.
+func New(logger Logger, config Config, sender SoapRequestSender, memcache AppMemCache, policies *AccessPolicies) *App {
 	app := App{
-		logger:            logger,
-		config:            config,
-		soapSender:        sender,
-		Appmemcache:       memcache,
-		permittedRequests: NewPermittedReqSyncMap(),
+		logger:        logger,
+		config:        config,
+		soapSender:    sender,
+		Appmemcache:   memcache,
+		policies:      policies,
+		latestDates:   NewLatestDateSyncMap(),
+		methods:       datastructures.NewDefaultMethodRegistry(),
+		requests:      newRequestGroup(),
+		cacheRequests: newCacheRequestCounter(),
 	}
-	app.permittedRequests.Init(permReqMap)
+	app.latestDates.Init()
 	return &app
 }
 
@@ -138,69 +191,207 @@ func (a *App) ProcessRequest(ctx context.Context, SOAPMethod string, startNodeNa
 	return nil
 }
 
+// XMLToStructDecoder decodes the start node of the response, response without it is an error, not an empty result.
 func (a *App) XMLToStructDecoder(data []byte, startNodeName string, pointerToStruct interface{}) error {
 	xmlData := bytes.NewBuffer(data)
 
 	d := xml.NewDecoder(xmlData)
 
-	for t, _ := d.Token(); t != nil; t, _ = d.Token() {
+	found := false
+	for {
+		t, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
 		switch se := t.(type) { //nolint: gocritic
 		case xml.StartElement:
 			if se.Name.Local == startNodeName {
-				err := d.DecodeElement(pointerToStruct, &se)
+				err = d.DecodeElement(pointerToStruct, &se)
 				if err != nil {
 					return err
 				}
+				found = true
 			}
 		}
 	}
+	if !found {
+		return fmt.Errorf("%w: %s", ErrStartNodeNotFound, startNodeName)
+	}
 	return nil
 }
 
-func (a *App) GetDataInCacheIfExisting(SOAPMethod string, rawBodyIn string) (interface{}, bool) { //nolint: gocritic
-	rawBody := helpers.ClearStringByWhitespaceAndLinebreak(rawBodyIn)
-	cachedData, ok := a.Appmemcache.GetCacheDataInCache(SOAPMethod + rawBody)
-	if ok {
-		if cachedData.InfoDTStamp.Add(a.config.GetInfoExpirTime()).After(time.Now()) {
-			return cachedData.Payload, true
+type cachedMethodData struct {
+	Payload interface{}
+	Age     time.Duration
+	// Staleness is time since expiration, it is not positive for fresh data
+	Staleness time.Duration
+}
+
+// lookupCache returns cached data of the method regardless of its expiration.
+// Data outdated by the latest publication date of CBR is stale since the publication.
+func (a *App) lookupCache(descriptor datastructures.MethodDescriptor, cacheKey string, responseType reflect.Type) (cachedMethodData, bool) { //nolint: gocritic
+	cachedData, ok := a.Appmemcache.GetCacheDataInCache(cacheKey)
+	if !ok {
+		return cachedMethodData{}, false
+	}
+	if reflect.TypeOf(cachedData.Payload) != responseType {
+		a.logger.Error(ErrAssertionAfterGetCacheData.Error())
+		return cachedMethodData{}, false
+	}
+	now := time.Now()
+	age := now.Sub(cachedData.InfoDTStamp)
+	expirDTStamp, expires := a.expirDTStamp(descriptor.Name, cachedData)
+	if !expires {
+		return cachedMethodData{Payload: cachedData.Payload, Age: age, Staleness: -age}, true
+	}
+	staleness := now.Sub(expirDTStamp)
+	if staleness == 0 {
+		// data is fresh only before the expiration moment
+		staleness = time.Nanosecond
+	}
+	return cachedMethodData{
+		Payload:   cachedData.Payload,
+		Age:       age,
+		Staleness: staleness,
+	}, true
+}
+
+// expirDTStamp returns the expiration moment of cached data, false if data never expires.
+// Historical data is not outdated by new publications of CBR, it expires by its own TTL, zero TTL is infinite.
+// TTL of not historical data can be overridden by the cache policy of the method.
+func (a *App) expirDTStamp(methodName string, cachedData memcache.CacheInfo) (time.Time, bool) { //nolint: gocritic
+	if cachedData.Historical {
+		historicalInfoExpirTime := a.config.GetHistoricalInfoExpirTime()
+		if historicalInfoExpirTime <= 0 {
+			return time.Time{}, false
 		}
+		return cachedData.InfoDTStamp.Add(historicalInfoExpirTime), true
 	}
-	return nil, false
+	expirDTStamp := cachedData.InfoDTStamp.Add(a.infoExpirTime(methodName))
+	if a.isOutdatedByLatestDate(methodName, cachedData.InfoDTStamp) {
+		descriptor, _ := a.methods.GetMethod(methodName)
+		info, _ := a.latestDates.GetLatestDateInfo(descriptor.LatestDateMethod)
+		if info.ChangeDTStamp.Before(expirDTStamp) {
+			expirDTStamp = info.ChangeDTStamp
+		}
+	}
+	return expirDTStamp, true
+}
+
+// isHistoricalRequest reports whether the requested date range is historical by its last date.
+func (a *App) isHistoricalRequest(descriptor datastructures.MethodDescriptor, request datastructures.RequestData) bool { //nolint: gocritic
+	endDate, ok := datastructures.RequestEndDate(request)
+	if !ok {
+		return false
+	}
+	return a.isHistoricalDate(descriptor, endDate)
 }
 
-func (a *App) AddOrUpdateDataInCache(SOAPMethod string, request interface{}, response interface{}) error { //nolint: gocritic
-	jsonstring, err := json.Marshal(request)
+// isHistoricalDate reports whether the date is before today and before the latest publication date of CBR,
+// if the method has it: data on such date is not changed any more.
+func (a *App) isHistoricalDate(descriptor datastructures.MethodDescriptor, date time.Time) bool { //nolint: gocritic
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if !date.Before(today) {
+		return false
+	}
+	if descriptor.LatestDateMethod == "" {
+		return true
+	}
+	info, ok := a.latestDates.GetLatestDateInfo(descriptor.LatestDateMethod)
+	if !ok {
+		return true
+	}
+	latestDate, err := time.Parse(latestDateLayout, info.LatestDate)
 	if err != nil {
-		a.logger.Error(err.Error())
-		return err
+		a.logger.Warning("bad latest date " + info.LatestDate + " of " + descriptor.LatestDateMethod + ": " + err.Error())
+		return false
+	}
+	return date.Before(latestDate)
+}
+
+// isOutdatedByLatestDate reports whether CBR has published new data for the method after it was cached.
+func (a *App) isOutdatedByLatestDate(methodName string, infoDTStamp time.Time) bool {
+	descriptor, ok := a.methods.GetMethod(methodName)
+	if !ok || descriptor.LatestDateMethod == "" {
+		return false
+	}
+	info, ok := a.latestDates.GetLatestDateInfo(descriptor.LatestDateMethod)
+	if !ok {
+		return false
 	}
+	return info.ChangeDTStamp.After(infoDTStamp)
+}
 
-	rawBody := helpers.ClearStringByWhitespaceAndLinebreak(string(jsonstring))
-	a.Appmemcache.AddOrUpdatePayloadInCache(SOAPMethod+rawBody, response)
+// CheckLatestDate refreshes the latest publication date the method depends on, if the previous check is too old.
+// Errors are only logged: an unavailable freshness method must not break the main request.
+func (a *App) CheckLatestDate(ctx context.Context, descriptor datastructures.MethodDescriptor) { //nolint: gocritic
+	if descriptor.LatestDateMethod == "" {
+		return
+	}
+	info, ok := a.latestDates.GetLatestDateInfo(descriptor.LatestDateMethod)
+	if ok && info.CheckDTStamp.Add(a.config.GetLatestDateCheckInterval()).After(time.Now()) {
+		return
+	}
+	latestDateDescriptor, ok := a.methods.GetMethod(descriptor.LatestDateMethod)
+	if !ok {
+		a.logger.Warning("latest date check error: " + ErrMethodNotFound.Error() + ": " + descriptor.LatestDateMethod)
+		return
+	}
+	request := latestDateDescriptor.NewRequest()
+	request.Init()
+	_, err := a.coalescedFetch(ctx, latestDateDescriptor, request, latestDateDescriptor.Name)
+	if err != nil {
+		a.logger.Warning("latest date check error: " + err.Error())
+	}
+}
+
+// RemoveMethodDataInCache removes cached data of the method request, raw body is JSON of the request as in the method handler.
+// Client of the context must be permitted to call the method, as in ProcessMethod.
+func (a *App) RemoveMethodDataInCache(ctx context.Context, methodName string, rawBody []byte) error {
+	descriptor, ok := a.methods.GetMethod(methodName)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrMethodNotFound, methodName)
+	}
+	err := a.policies.Check(APIKeyFromContext(ctx), descriptor)
+	if err != nil {
+		return err
+	}
+	input := descriptor.NewRequest()
+	if !descriptor.WithoutParams {
+		err = json.Unmarshal(rawBody, input)
+		if err != nil {
+			return fmt.Errorf("%w: %s", datastructures.ErrBadRawData, err.Error())
+		}
+	}
+	request, err := normalizedRequest(descriptor, input)
+	if err != nil {
+		return err
+	}
+	cacheKey, err := datastructures.CacheKey(descriptor.Name, request)
+	if err != nil {
+		return err
+	}
+	a.Appmemcache.RemovePayloadInCache(cacheKey)
+	if descriptor.RangeDateField != "" {
+		// otherwise the merged result is rebuilt from cached days without CBR WS call
+		a.removeRangeDays(descriptor, request)
+	}
 	return nil
 }
 
-func (a *App) RemoveDataInMemCacheBySOAPAction(tag string) {
-	a.Appmemcache.RemovePayloadInCache(tag)
-}
-
-func (a *App) StartCacheCleaner(ctx context.Context) {
-	a.logger.Info("CacheCleaner start")
-	InfoExpirTime := a.config.GetInfoExpirTime()
-	InfoClearTimeDelta := a.config.GetInfoClearTimeDelta()
-	timer := time.AfterFunc(InfoExpirTime, func() {
-		ticker := time.NewTicker(InfoClearTimeDelta)
-		for {
-			select {
-			case <-ctx.Done():
-				a.logger.Info("CacheCleaner stop")
-				break
-			case <-ticker.C:
-				curTime := time.Now().Add(-1 * InfoExpirTime)
-				a.Appmemcache.RemoveAllPayloadInCacheByTimeStamp(curTime)
-				a.logger.Info("CacheCleaner clean cash")
+// maxStaleWindow is the time expired data is kept in cache for stale responses.
+func (a *App) maxStaleWindow() time.Duration {
+	var maxWindow time.Duration
+	for _, descriptor := range a.methods.GetMethods() {
+		for _, window := range []time.Duration{a.config.GetStaleWhileRevalidate(descriptor.Name), a.config.GetStaleIfError(descriptor.Name)} {
+			if window > maxWindow {
+				maxWindow = window
 			}
 		}
-	})
-	defer timer.Stop()
+	}
+	return maxWindow
 }
@@ -1,8 +1,14 @@
 package app_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,22 +20,15 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-type testStruct struct {
-	Field1 string
-	Field2 string
-	Field3 int
-}
-
 type AllCasesTable struct {
 	CasesByMethod []AppTestTable
 }
 
 type AppTestTable struct {
-	MethodName string
-	Method     func(*app.App, context.Context, interface{}, string) (interface{}, error)
-	MethodWP   func(*app.App, context.Context) (interface{}, error)
-	IsMethodWP bool
-	TestCases  []AppTestCase
+	MethodName  string
+	IsMethodWP  bool
+	IsNotCached bool
+	TestCases   []AppTestCase
 }
 
 type AppTestCase struct {
@@ -54,61 +53,1104 @@ func initTestApp(t *testing.T) *app.App {
 	return testApp
 }
 
-func TestPermittedReqSyncMap(t *testing.T) {
-	testPermittedReqSyncMap := app.PermittedReqSyncMap{}
-	testPermittedReqSyncMap.Init(nil)
+func TestAccessPolicy(t *testing.T) {
 	t.Parallel()
-	t.Run("TestPermittedReqSyncMap: AddPermittedRequest_And_IsPermittedRequestInMap", func(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    app.AccessPolicy
+		names     []string
+		permitted bool
+	}{
+		{name: "VoidPolicy", policy: app.AccessPolicy{}, names: []string{"KeyRateXML"}, permitted: true},
+		{name: "InAllowList", policy: app.AccessPolicy{Allow: []string{"KeyRateXML"}}, names: []string{"KeyRateXML"}, permitted: true},
+		{name: "NotInAllowList", policy: app.AccessPolicy{Allow: []string{"KeyRateXML"}}, names: []string{"DVXML"}, permitted: false},
+		{name: "InDenyList", policy: app.AccessPolicy{Deny: []string{"KeyRateXML"}}, names: []string{"KeyRateXML"}, permitted: false},
+		{name: "NotInDenyList", policy: app.AccessPolicy{Deny: []string{"KeyRateXML"}}, names: []string{"DVXML"}, permitted: true},
+		{name: "DenyWins", policy: app.AccessPolicy{Allow: []string{"GetCurs*"}, Deny: []string{"GetCursDynamicXML"}}, names: []string{"GetCursDynamicXML"}, permitted: false},
+		{name: "AllowWildcard", policy: app.AccessPolicy{Allow: []string{"GetCurs*"}, Deny: []string{"GetCursDynamicXML"}}, names: []string{"GetCursOnDateXML"}, permitted: true},
+		{name: "DenyWildcard", policy: app.AccessPolicy{Deny: []string{"Swap*"}}, names: []string{"SwapDynamicXML"}, permitted: false},
+		{name: "SOAPMethodName", policy: app.AccessPolicy{Allow: []string{"Coins_baseXML"}}, names: []string{"CoinsBaseXML", "Coins_baseXML"}, permitted: true},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run("TestAccessPolicy: "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+			require.NoError(t, testCase.policy.Validate())
+			require.Equal(t, testCase.permitted, testCase.policy.IsPermitted(testCase.names...))
+		})
+	}
+	t.Run("TestAccessPolicy: BadPattern", func(t *testing.T) {
 		t.Parallel()
-		testPermittedReqSyncMap := app.PermittedReqSyncMap{}
-		testPermittedReqSyncMap.Init(nil)
-		testData := testPermittedReqSyncMap.IsPermittedRequestInMap("test1")
-		require.Equal(t, false, testData)
-		testPermittedReqSyncMap.AddPermittedRequest("test1")
-		testData = testPermittedReqSyncMap.IsPermittedRequestInMap("test1")
-		require.Equal(t, true, testData)
+		policy := app.AccessPolicy{Deny: []string{"Get[Curs"}}
+		require.Error(t, policy.Validate())
+		_, err := app.NewAccessPolicies(policy, nil, false)
+		require.Error(t, err)
+		_, err = app.NewAccessPolicies(app.AccessPolicy{}, map[string]app.AccessPolicy{"key1": policy}, false)
+		require.Error(t, err)
 	})
-	t.Run("TestPermittedReqSyncMap: AddPermittedRequest_And_PermittedRequestMapLength", func(t *testing.T) {
+}
+
+func TestLoadAPIKeyPolicies(t *testing.T) {
+	t.Parallel()
+	policies, err := app.LoadAPIKeyPolicies("")
+	require.NoError(t, err)
+	require.Nil(t, policies)
+
+	filePath := filepath.Join(t.TempDir(), "apikeys.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"key1":{"allow":["GetCurs*"],"deny":["GetCursDynamicXML"]},"key2":{}}`), 0o600))
+	policies, err = app.LoadAPIKeyPolicies(filePath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]app.AccessPolicy{
+		"key1": {Allow: []string{"GetCurs*"}, Deny: []string{"GetCursDynamicXML"}},
+		"key2": {},
+	}, policies)
+
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"key1":`), 0o600))
+	_, err = app.LoadAPIKeyPolicies(filePath)
+	require.Error(t, err)
+	_, err = app.LoadAPIKeyPolicies(filepath.Join(t.TempDir(), "absent.json"))
+	require.Error(t, err)
+}
+
+func initTestAppWithPolicies(t *testing.T, policies *app.AccessPolicies) *app.App {
+	t.Helper()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	configMock := mocks.ConfigMock{}
+	senderMock := mocks.SoapRequestSenderMock{}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	return app.New(loggerMock, &configMock, &senderMock, appMemcache, policies)
+}
+
+func processMethodWithAPIKey(testApp *app.App, apiKey string, descriptor datastructures.MethodDescriptor) error { //nolint: gocritic
+	ctx := context.Background()
+	if apiKey != "" {
+		ctx = app.WithAPIKey(ctx, apiKey)
+	}
+	input := descriptor.NewRequest()
+	input.Init()
+	_, err := testApp.ProcessMethod(ctx, descriptor.Name, input)
+	return err
+}
+
+func TestAccessPoliciesAllMethods(t *testing.T) {
+	t.Parallel()
+	for _, descriptor := range datastructures.DefaultMethodDescriptors() {
+		descriptor := descriptor
+		t.Run("TestAccessPoliciesAllMethods: "+descriptor.Name, func(t *testing.T) {
+			t.Parallel()
+			denyByName, err := app.NewAccessPolicies(app.AccessPolicy{Deny: []string{descriptor.Name}}, nil, false)
+			require.NoError(t, err)
+			err = processMethodWithAPIKey(initTestAppWithPolicies(t, denyByName), "", descriptor)
+			require.ErrorIs(t, err, app.ErrMethodProhibited)
+
+			allowOther, err := app.NewAccessPolicies(app.AccessPolicy{Allow: []string{"UnknownXML"}}, nil, false)
+			require.NoError(t, err)
+			err = processMethodWithAPIKey(initTestAppWithPolicies(t, allowOther), "", descriptor)
+			require.ErrorIs(t, err, app.ErrMethodProhibited)
+
+			allowBySOAPMethod, err := app.NewAccessPolicies(app.AccessPolicy{Allow: []string{descriptor.SOAPMethod}}, nil, false)
+			require.NoError(t, err)
+			err = processMethodWithAPIKey(initTestAppWithPolicies(t, allowBySOAPMethod), "", descriptor)
+			require.NotErrorIs(t, err, app.ErrMethodProhibited)
+
+			apiKeyPolicies, err := app.NewAccessPolicies(
+				app.AccessPolicy{Deny: []string{"*"}},
+				map[string]app.AccessPolicy{
+					"full":    {},
+					"limited": {Deny: []string{descriptor.Name}},
+				},
+				false,
+			)
+			require.NoError(t, err)
+			testApp := initTestAppWithPolicies(t, apiKeyPolicies)
+			err = processMethodWithAPIKey(testApp, "", descriptor)
+			require.ErrorIs(t, err, app.ErrMethodProhibited)
+			err = processMethodWithAPIKey(testApp, "full", descriptor)
+			require.NotErrorIs(t, err, app.ErrMethodProhibited)
+			err = processMethodWithAPIKey(testApp, "limited", descriptor)
+			require.ErrorIs(t, err, app.ErrMethodProhibited)
+			err = processMethodWithAPIKey(testApp, "unknown", descriptor)
+			require.ErrorIs(t, err, app.ErrUnknownAPIKey)
+			// cached data is removed only by permitted clients
+			err = testApp.RemoveMethodDataInCache(app.WithAPIKey(context.Background(), "limited"), descriptor.Name, []byte("{}"))
+			require.ErrorIs(t, err, app.ErrMethodProhibited)
+			err = testApp.RemoveMethodDataInCache(app.WithAPIKey(context.Background(), "full"), descriptor.Name, []byte("{}"))
+			require.NoError(t, err)
+
+			// the limited client can not leave API key out to get the default policy
+			apiKeyRequired, err := app.NewAccessPolicies(app.AccessPolicy{}, map[string]app.AccessPolicy{"limited": {Deny: []string{descriptor.Name}}}, true)
+			require.NoError(t, err)
+			testApp = initTestAppWithPolicies(t, apiKeyRequired)
+			err = processMethodWithAPIKey(testApp, "", descriptor)
+			require.ErrorIs(t, err, app.ErrAPIKeyRequired)
+			err = processMethodWithAPIKey(testApp, "limited", descriptor)
+			require.ErrorIs(t, err, app.ErrMethodProhibited)
+			// API key is not required without policies of API keys
+			apiKeyRequired, err = app.NewAccessPolicies(app.AccessPolicy{}, nil, true)
+			require.NoError(t, err)
+			err = processMethodWithAPIKey(initTestAppWithPolicies(t, apiKeyRequired), "", descriptor)
+			require.NotErrorIs(t, err, app.ErrAPIKeyRequired)
+		})
+	}
+}
+
+func TestLatestDateSyncMap(t *testing.T) {
+	t.Parallel()
+	t.Run("TestLatestDateSyncMap: UpdateLatestDate_And_GetLatestDateInfo", func(t *testing.T) {
 		t.Parallel()
-		testPermittedReqSyncMap := app.PermittedReqSyncMap{}
-		testPermittedReqSyncMap.Init(nil)
-		testPermittedReqSyncMap.AddPermittedRequest("test1")
-		testPermittedReqSyncMap.AddPermittedRequest("test2")
-		testData := testPermittedReqSyncMap.PermittedRequestMapLength()
-		require.Equal(t, 2, testData)
+		testLatestDateSyncMap := app.NewLatestDateSyncMap()
+		testLatestDateSyncMap.Init()
+		_, ok := testLatestDateSyncMap.GetLatestDateInfo("GetLatestDateTime")
+		require.Equal(t, false, ok)
+		changed := testLatestDateSyncMap.UpdateLatestDate("GetLatestDateTime", "2023-06-22T00:00:00")
+		require.Equal(t, false, changed)
+		info, ok := testLatestDateSyncMap.GetLatestDateInfo("GetLatestDateTime")
+		require.Equal(t, true, ok)
+		require.Equal(t, "2023-06-22T00:00:00", info.LatestDate)
+		require.Equal(t, true, info.ChangeDTStamp.IsZero())
+		changed = testLatestDateSyncMap.UpdateLatestDate("GetLatestDateTime", "2023-06-22T00:00:00")
+		require.Equal(t, false, changed)
+		changed = testLatestDateSyncMap.UpdateLatestDate("GetLatestDateTime", "2023-06-23T00:00:00")
+		require.Equal(t, true, changed)
+		info, ok = testLatestDateSyncMap.GetLatestDateInfo("GetLatestDateTime")
+		require.Equal(t, true, ok)
+		require.Equal(t, "2023-06-23T00:00:00", info.LatestDate)
+		require.Equal(t, false, info.ChangeDTStamp.IsZero())
 	})
 }
 
-func TestGenerateTagForMemCacheLogic(t *testing.T) {
-	testApp := initTestApp(t)
-	testStruct1 := testStruct{
-		Field1: "abc",
-		Field2: "def",
-		Field3: 0,
+type latestDateSenderMock struct {
+	mocks.SoapRequestSenderMock
+	mu         sync.Mutex
+	latestDate string
+}
+
+func (ldsm *latestDateSenderMock) setLatestDate(latestDate string) {
+	ldsm.mu.Lock()
+	defer ldsm.mu.Unlock()
+	ldsm.latestDate = latestDate
+}
+
+func (ldsm *latestDateSenderMock) SoapCall(ctx context.Context, action string, input interface{}) ([]byte, error) {
+	if action != "GetLatestDateTime" {
+		return ldsm.SoapRequestSenderMock.SoapCall(ctx, action, input)
 	}
-	testStruct2 := testStruct{
-		Field1: "123",
-		Field2: "456",
-		Field3: 1,
+	ldsm.mu.Lock()
+	defer ldsm.mu.Unlock()
+	return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><GetLatestDateTimeResponse xmlns="http://web.cbr.ru/"><GetLatestDateTimeResult>` + ldsm.latestDate + `</GetLatestDateTimeResult></GetLatestDateTimeResponse></soap:Body></soap:Envelope>`), nil
+}
+
+func TestLatestDateCacheInvalidation(t *testing.T) {
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	configMock := mocks.ConfigMock{}
+	senderMock := latestDateSenderMock{latestDate: "2023-06-22T00:00:00"}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &configMock, &senderMock, appMemcache, nil)
+	input := &datastructures.GetCursOnDateXML{
+		OnDate: "2023-06-22",
 	}
-	err := testApp.AddOrUpdateDataInCache("ts1", testStruct1, testStruct1.Field3)
+	cacheTag := getTagForCache(t, "GetCursOnDateXML", input)
+
+	_, err = testApp.ProcessMethod(context.Background(), "GetCursOnDateXML", input)
 	require.NoError(t, err)
-	err = testApp.AddOrUpdateDataInCache("ts2", testStruct2, testStruct2.Field3)
+	cachedData, ok := testApp.Appmemcache.GetCacheDataInCache(cacheTag)
+	require.Equal(t, true, ok)
+
+	_, err = testApp.ProcessMethod(context.Background(), "GetCursOnDateXML", input)
 	require.NoError(t, err)
-	rawBody, err := json.Marshal(testStruct1)
+	cachedData2, ok := testApp.Appmemcache.GetCacheDataInCache(cacheTag)
+	require.Equal(t, true, ok)
+	require.Equal(t, cachedData.InfoDTStamp, cachedData2.InfoDTStamp)
+
+	time.Sleep(time.Millisecond)
+	senderMock.setLatestDate("2023-06-23T00:00:00")
+	_, err = testApp.ProcessMethod(context.Background(), "GetCursOnDateXML", input)
 	require.NoError(t, err)
-	payload1, ok := testApp.GetDataInCacheIfExisting("ts1", string(rawBody))
+	cachedData3, ok := testApp.Appmemcache.GetCacheDataInCache(cacheTag)
 	require.Equal(t, true, ok)
-	data1, ok := payload1.(int)
+	require.NotEqual(t, cachedData2.InfoDTStamp, cachedData3.InfoDTStamp)
+}
+
+func TestProcessMethodErrors(t *testing.T) {
+	t.Parallel()
+	testApp := initTestApp(t)
+	_, err := testApp.ProcessMethod(context.Background(), "UnknownXML", nil)
+	require.ErrorIs(t, err, app.ErrMethodNotFound)
+	_, err = testApp.ProcessMethod(context.Background(), "GetCursOnDateXML", &datastructures.KeyRateXML{})
+	require.ErrorIs(t, err, app.ErrAssertionOfInputData)
+	_, err = testApp.ProcessMethod(context.Background(), "GetCursOnDateXML", datastructures.GetCursOnDateXML{OnDate: "2023-06-22"})
+	require.ErrorIs(t, err, app.ErrAssertionOfInputData)
+	require.Equal(t, len(datastructures.DefaultMethodDescriptors()), len(testApp.GetMethodDescriptors()))
+}
+
+type staticSenderMock struct {
+	body []byte
+	err  error
+}
+
+func (ssm *staticSenderMock) SoapCall(_ context.Context, _ string, _ interface{}) ([]byte, error) {
+	return ssm.body, ssm.err
+}
+
+func TestFailedResponsesNotCached(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		sender staticSenderMock
+		err    error
+		// method is KeyRateXML, if it is void
+		method string
+	}{
+		{
+			name:   "SOAPFault",
+			sender: staticSenderMock{err: &customsoap.SOAPFault{Code: "soap:Server", String: "Server was unable to process request.", HTTPStatus: 500}},
+		},
+		{
+			name:   "BadHTTPStatus",
+			sender: staticSenderMock{err: customsoap.ErrBadHTTPStatus},
+			err:    customsoap.ErrBadHTTPStatus,
+		},
+		{
+			name:   "NoStartNode",
+			sender: staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><KeyRateXMLResponse xmlns="http://web.cbr.ru/"><KeyRateXMLResult /></KeyRateXMLResponse></soap:Body></soap:Envelope>`)},
+			err:    app.ErrStartNodeNotFound,
+		},
+		{
+			name:   "BadXML",
+			sender: staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><KeyRateXMLResponse xmlns="http://web.cbr.ru/">`)},
+		},
+		{
+			name:   "EmptyResult",
+			sender: staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><KeyRateXMLResponse xmlns="http://web.cbr.ru/"><KeyRateXMLResult><KeyRate xmlns="" /></KeyRateXMLResult></KeyRateXMLResponse></soap:Body></soap:Envelope>`)},
+		},
+		{
+			name:   "EmptyResultWithAttributes",
+			sender: staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><GetCursOnDateXMLResponse xmlns="http://web.cbr.ru/"><GetCursOnDateXMLResult><ValuteData xmlns="" OnDate="20230624" /></GetCursOnDateXMLResult></GetCursOnDateXMLResponse></soap:Body></soap:Envelope>`)},
+			method: "GetCursOnDateXML",
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run("TestFailedResponsesNotCached: "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+			loggerMock, err := mocks.NewLoggerMock(false)
+			require.NoError(t, err)
+			appMemcache := memcache.New()
+			appMemcache.Init()
+			testApp := app.New(loggerMock, &mocks.ConfigMock{}, &testCase.sender, appMemcache, nil)
+			var input datastructures.RequestData = &datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+			method := "KeyRateXML"
+			if testCase.method != "" {
+				input = &datastructures.GetCursOnDateXML{OnDate: "2023-06-24"}
+				method = testCase.method
+			}
+			input.Init()
+
+			response, err := testApp.ProcessMethod(context.Background(), method, input)
+			switch {
+			case testCase.name == "EmptyResult":
+				require.NoError(t, err)
+				require.Equal(t, datastructures.KeyRateXMLResult{}, response)
+			case testCase.name == "EmptyResultWithAttributes":
+				require.NoError(t, err)
+				require.Equal(t, datastructures.GetCursOnDateXMLResult{OnDate: "20230624"}, response)
+			case testCase.err != nil:
+				require.ErrorIs(t, err, testCase.err)
+				require.Equal(t, datastructures.KeyRateXMLResult{}, response)
+			default:
+				require.Error(t, err)
+				require.Equal(t, datastructures.KeyRateXMLResult{}, response)
+			}
+			_, ok := testApp.Appmemcache.GetCacheDataInCache(getTagForCache(t, method, input))
+			require.Equal(t, false, ok)
+		})
+	}
+}
+
+func TestStaleDataWhileCircuitOpen(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><KeyRateXMLResponse xmlns="http://web.cbr.ru/"><KeyRateXMLResult><KeyRate xmlns=""><KR><DT>2023-06-22T00:00:00+03:00</DT><Rate>7.50</Rate></KR></KeyRate></KeyRateXMLResult></KeyRateXMLResponse></soap:Body></soap:Envelope>`)}
+	breaker, err := customsoap.NewCircuitBreaker(loggerMock, &senderMock, customsoap.CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenMaxCalls: 1}, customsoap.CircuitBreakerMetrics{})
+	require.NoError(t, err)
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &mocks.ConfigMock{}, breaker, appMemcache, nil)
+	status, ok := testApp.GetCircuitBreakerStatus()
+	require.Equal(t, true, ok)
+	require.Equal(t, customsoap.StateClosed, status.State)
+
+	input := &datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+	input.Init()
+	response, err := testApp.ProcessMethod(context.Background(), "KeyRateXML", input)
+	require.NoError(t, err)
+
+	// cached data is expired (INFO_EXPIR_TIME of the config mock is 1s), CBR WS is unavailable.
+	time.Sleep(1100 * time.Millisecond)
+	senderMock.body = nil
+	senderMock.err = &customsoap.HTTPStatusError{StatusCode: 503, Status: "503 Service Unavailable"}
+	_, err = testApp.ProcessMethod(context.Background(), "KeyRateXML", input)
+	require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+	status, ok = testApp.GetCircuitBreakerStatus()
 	require.Equal(t, true, ok)
-	require.Equal(t, testStruct1.Field3, data1)
-	rawBody, err = json.Marshal(testStruct2)
+	require.Equal(t, customsoap.StateOpen, status.State)
+
+	staleResponse, err := testApp.ProcessMethod(context.Background(), "KeyRateXML", input)
 	require.NoError(t, err)
-	payload2, ok := testApp.GetDataInCacheIfExisting("ts2", string(rawBody))
+	require.Equal(t, response, staleResponse)
+
+	otherInput := &datastructures.KeyRateXML{FromDate: "2023-06-21", ToDate: "2023-06-23"}
+	otherInput.Init()
+	_, err = testApp.ProcessMethod(context.Background(), "KeyRateXML", otherInput)
+	require.ErrorIs(t, err, customsoap.ErrCircuitOpen)
+
+	_, ok = initTestApp(t).GetCircuitBreakerStatus()
+	require.Equal(t, false, ok)
+}
+
+type staleConfigMock struct {
+	mocks.ConfigMock
+	staleWhileRevalidate time.Duration
+	staleIfError         time.Duration
+}
+
+func (config *staleConfigMock) GetStaleWhileRevalidate(_ string) time.Duration {
+	return config.staleWhileRevalidate
+}
+
+func (config *staleConfigMock) GetStaleIfError(_ string) time.Duration {
+	return config.staleIfError
+}
+
+type keyRateSenderMock struct {
+	mu    sync.Mutex
+	rate  string
+	err   error
+	delay time.Duration
+	calls int
+}
+
+func (krsm *keyRateSenderMock) set(rate string, err error) {
+	krsm.mu.Lock()
+	defer krsm.mu.Unlock()
+	krsm.rate = rate
+	krsm.err = err
+}
+
+func (krsm *keyRateSenderMock) getCalls() int {
+	krsm.mu.Lock()
+	defer krsm.mu.Unlock()
+	return krsm.calls
+}
+
+func (krsm *keyRateSenderMock) SoapCall(_ context.Context, _ string, _ interface{}) ([]byte, error) {
+	krsm.mu.Lock()
+	krsm.calls++
+	delay := krsm.delay
+	krsm.mu.Unlock()
+	time.Sleep(delay)
+	krsm.mu.Lock()
+	defer krsm.mu.Unlock()
+	if krsm.err != nil {
+		return nil, krsm.err
+	}
+	return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><KeyRateXMLResponse xmlns="http://web.cbr.ru/"><KeyRateXMLResult><KeyRate xmlns=""><KR><DT>2023-06-22T00:00:00+03:00</DT><Rate>` + krsm.rate + `</Rate></KR></KeyRate></KeyRateXMLResult></KeyRateXMLResponse></soap:Body></soap:Envelope>`), nil
+}
+
+func processKeyRate(t *testing.T, testApp *app.App) (string, app.ResponseCacheInfo, error) {
+	t.Helper()
+	input := &datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+	input.Init()
+	response, cacheInfo, err := testApp.ProcessMethodWithCacheInfo(context.Background(), "KeyRateXML", input)
+	keyRate, ok := response.(datastructures.KeyRateXMLResult)
 	require.Equal(t, true, ok)
-	data2, ok := payload2.(int)
+	if len(keyRate.KR) == 0 {
+		return "", cacheInfo, err
+	}
+	return keyRate.KR[0].Rate, cacheInfo, err
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := keyRateSenderMock{rate: "7.50"}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &staleConfigMock{staleWhileRevalidate: time.Minute}, &senderMock, appMemcache, nil)
+
+	rate, cacheInfo, err := processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.50", rate)
+	require.Equal(t, app.CacheMiss, cacheInfo.Status)
+	rate, cacheInfo, err = processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.50", rate)
+	require.Equal(t, app.CacheHit, cacheInfo.Status)
+	require.Equal(t, 1, senderMock.getCalls())
+
+	// INFO_EXPIR_TIME of the config mock is 1s.
+	time.Sleep(1100 * time.Millisecond)
+	senderMock.set("8.00", nil)
+	rate, cacheInfo, err = processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.50", rate)
+	require.Equal(t, app.CacheStale, cacheInfo.Status)
+	require.Equal(t, app.StaleReasonRevalidate, cacheInfo.StaleReason)
+	require.GreaterOrEqual(t, cacheInfo.Age, time.Second)
+
+	require.Eventually(t, func() bool {
+		rate, cacheInfo, err = processKeyRate(t, testApp)
+		return err == nil && rate == "8.00" && cacheInfo.Status == app.CacheHit
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, 2, senderMock.getCalls())
+}
+
+func TestStaleIfError(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name         string
+		staleIfError time.Duration
+	}{
+		{name: "InWindow", staleIfError: time.Minute},
+		{name: "OutOfWindow", staleIfError: 0},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run("TestStaleIfError: "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+			loggerMock, err := mocks.NewLoggerMock(false)
+			require.NoError(t, err)
+			senderMock := keyRateSenderMock{rate: "7.50"}
+			appMemcache := memcache.New()
+			appMemcache.Init()
+			testApp := app.New(loggerMock, &staleConfigMock{staleIfError: testCase.staleIfError}, &senderMock, appMemcache, nil)
+
+			_, _, err = processKeyRate(t, testApp)
+			require.NoError(t, err)
+			time.Sleep(1100 * time.Millisecond)
+			senderMock.set("", &customsoap.HTTPStatusError{StatusCode: 503, Status: "503 Service Unavailable"})
+			rate, cacheInfo, err := processKeyRate(t, testApp)
+			if testCase.staleIfError == 0 {
+				require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+				require.Equal(t, app.CacheMiss, cacheInfo.Status)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "7.50", rate)
+			require.Equal(t, app.CacheStale, cacheInfo.Status)
+			require.Equal(t, app.StaleReasonError, cacheInfo.StaleReason)
+		})
+	}
+}
+
+func TestRequestCoalescing(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := keyRateSenderMock{rate: "7.50", delay: 100 * time.Millisecond}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &mocks.ConfigMock{}, &senderMock, appMemcache, nil)
+
+	const requestsCount = 20
+	var wg sync.WaitGroup
+	rates := make([]string, requestsCount)
+	errs := make([]error, requestsCount)
+	for i := 0; i < requestsCount; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rates[i], _, errs[i] = processKeyRate(t, testApp)
+		}()
+	}
+	wg.Wait()
+	for i := 0; i < requestsCount; i++ {
+		require.NoError(t, errs[i])
+		require.Equal(t, "7.50", rates[i])
+	}
+	require.Equal(t, 1, senderMock.getCalls())
+
+	// waiter stops on its own context, the first request is not canceled.
+	time.Sleep(1100 * time.Millisecond)
+	input := &datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+	input.Init()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		rates[0], _, errs[0] = processKeyRate(t, testApp)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = testApp.ProcessMethod(ctx, "KeyRateXML", input)
+	require.ErrorIs(t, err, app.ErrContextWSReqExpired)
+	wg.Wait()
+	require.NoError(t, errs[0])
+	require.Equal(t, "7.50", rates[0])
+	require.Equal(t, 2, senderMock.getCalls())
+}
+
+func TestCanonicalCacheKey(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := keyRateSenderMock{rate: "7.50"}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &staleConfigMock{}, &senderMock, appMemcache, nil)
+
+	rawBodies := []string{
+		`{"FromDate":"2023-06-22","ToDate":"2023-06-23"}`,
+		`{"ToDate": "2023-06-23", "FromDate": "2023-06-22"}`,
+		`{"todate":"2023-06-23","fromdate":" 2023-06-22"}`,
+	}
+	for _, rawBody := range rawBodies {
+		input := datastructures.KeyRateXML{}
+		require.NoError(t, json.Unmarshal([]byte(rawBody), &input))
+		_, cacheInfo, err := testApp.ProcessMethodWithCacheInfo(context.Background(), "KeyRateXML", &input)
+		require.NoError(t, err)
+		if rawBody != rawBodies[0] {
+			require.Equal(t, app.CacheHit, cacheInfo.Status)
+		}
+	}
+	require.Equal(t, 1, senderMock.getCalls())
+
+	err = testApp.RemoveMethodDataInCache(context.Background(), "KeyRateXML", []byte(rawBodies[2]))
+	require.NoError(t, err)
+	_, cacheInfo, err := processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, app.CacheMiss, cacheInfo.Status)
+	require.Equal(t, 2, senderMock.getCalls())
+
+	err = testApp.RemoveMethodDataInCache(context.Background(), "UnknownXML", []byte(rawBodies[0]))
+	require.ErrorIs(t, err, app.ErrMethodNotFound)
+	err = testApp.RemoveMethodDataInCache(context.Background(), "KeyRateXML", []byte(`{"FromDate":`))
+	require.ErrorIs(t, err, datastructures.ErrBadRawData)
+}
+
+type historicalConfigMock struct {
+	mocks.ConfigMock
+}
+
+func (config *historicalConfigMock) GetHistoricalInfoExpirTime() time.Duration {
+	return 0
+}
+
+func TestHistoricalCache(t *testing.T) {
+	t.Parallel()
+	t.Run("TestHistoricalCache: DateRange", func(t *testing.T) {
+		t.Parallel()
+		loggerMock, err := mocks.NewLoggerMock(false)
+		require.NoError(t, err)
+		senderMock := keyRateSenderMock{rate: "7.50"}
+		appMemcache := memcache.New()
+		appMemcache.Init()
+		testApp := app.New(loggerMock, &historicalConfigMock{}, &senderMock, appMemcache, nil)
+		today := time.Now().Format("2006-01-02")
+		liveInput := &datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: today}
+
+		_, _, err = processKeyRate(t, testApp)
+		require.NoError(t, err)
+		_, err = testApp.ProcessMethod(context.Background(), "KeyRateXML", liveInput)
+		require.NoError(t, err)
+		require.Equal(t, 2, senderMock.getCalls())
+		time.Sleep(1100 * time.Millisecond)
+		testApp.Appmemcache.RemoveAllPayloadInCacheByTimeStamp(time.Now())
+
+		_, cacheInfo, err := processKeyRate(t, testApp)
+		require.NoError(t, err)
+		require.Equal(t, app.CacheHit, cacheInfo.Status)
+		_, cacheInfo, err = testApp.ProcessMethodWithCacheInfo(context.Background(), "KeyRateXML", liveInput)
+		require.NoError(t, err)
+		require.Equal(t, app.CacheMiss, cacheInfo.Status)
+		require.Equal(t, 3, senderMock.getCalls())
+	})
+	t.Run("TestHistoricalCache: LatestDate", func(t *testing.T) {
+		t.Parallel()
+		loggerMock, err := mocks.NewLoggerMock(false)
+		require.NoError(t, err)
+		senderMock := latestDateSenderMock{latestDate: "2023-06-22T00:00:00"}
+		appMemcache := memcache.New()
+		appMemcache.Init()
+		testApp := app.New(loggerMock, &historicalConfigMock{}, &senderMock, appMemcache, nil)
+		input := &datastructures.GetCursOnDateXML{OnDate: "2023-06-22"}
+		cacheTag := getTagForCache(t, "GetCursOnDateXML", input)
+
+		// data on the latest publication date can be changed
+		_, err = testApp.ProcessMethod(context.Background(), "GetCursOnDateXML", input)
+		require.NoError(t, err)
+		cachedData, ok := testApp.Appmemcache.GetCacheDataInCache(cacheTag)
+		require.Equal(t, true, ok)
+		require.Equal(t, false, cachedData.Historical)
+
+		time.Sleep(time.Millisecond)
+		senderMock.setLatestDate("2023-06-23T00:00:00")
+		_, cacheInfo, err := testApp.ProcessMethodWithCacheInfo(context.Background(), "GetCursOnDateXML", input)
+		require.NoError(t, err)
+		require.Equal(t, app.CacheMiss, cacheInfo.Status)
+		cachedData, ok = testApp.Appmemcache.GetCacheDataInCache(cacheTag)
+		require.Equal(t, true, ok)
+		require.Equal(t, true, cachedData.Historical)
+
+		time.Sleep(time.Millisecond)
+		senderMock.setLatestDate("2023-06-24T00:00:00")
+		_, cacheInfo, err = testApp.ProcessMethodWithCacheInfo(context.Background(), "GetCursOnDateXML", input)
+		require.NoError(t, err)
+		require.Equal(t, app.CacheHit, cacheInfo.Status)
+	})
+}
+
+// ruoniaRangeSenderMock answers RuoniaXML with one element on every weekday of the requested range.
+type ruoniaRangeSenderMock struct {
+	mu       sync.Mutex
+	err      error
+	requests []string
+}
+
+func (rrsm *ruoniaRangeSenderMock) setErr(err error) {
+	rrsm.mu.Lock()
+	defer rrsm.mu.Unlock()
+	rrsm.err = err
+}
+
+func (rrsm *ruoniaRangeSenderMock) getRequests() []string {
+	rrsm.mu.Lock()
+	defer rrsm.mu.Unlock()
+	return append([]string(nil), rrsm.requests...)
+}
+
+func (rrsm *ruoniaRangeSenderMock) SoapCall(_ context.Context, _ string, input interface{}) ([]byte, error) {
+	request, ok := input.(datastructures.RuoniaXML)
+	if !ok {
+		return nil, mocks.ErrAssertion
+	}
+	rrsm.mu.Lock()
+	rrsm.requests = append(rrsm.requests, request.FromDate+".."+request.ToDate)
+	err := rrsm.err
+	rrsm.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	fromDate, err := time.Parse("2006-01-02", request.FromDate)
+	if err != nil {
+		return nil, err
+	}
+	toDate, err := time.Parse("2006-01-02", request.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	var elements strings.Builder
+	for day := fromDate; !day.After(toDate); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		fmt.Fprintf(&elements, "<ro><D0>%sT00:00:00+03:00</D0><ruo>7.%02d</ruo><vol>%d.00</vol><DateUpdate>%sT00:00:00+03:00</DateUpdate></ro>",
+			day.Format("2006-01-02"), day.Day(), day.YearDay(), day.AddDate(0, 0, 1).Format("2006-01-02"))
+	}
+	return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><RuoniaXMLResponse xmlns="http://web.cbr.ru/"><RuoniaXMLResult><Ruonia xmlns="">` + elements.String() + `</Ruonia></RuoniaXMLResult></RuoniaXMLResponse></soap:Body></soap:Envelope>`), nil
+}
+
+func processRuonia(t *testing.T, testApp *app.App, fromDate string, toDate string) (datastructures.RuoniaXMLResult, error) {
+	t.Helper()
+	input := &datastructures.RuoniaXML{FromDate: fromDate, ToDate: toDate}
+	input.Init()
+	response, err := testApp.ProcessMethod(context.Background(), "RuoniaXML", input)
+	ruonia, ok := response.(datastructures.RuoniaXMLResult)
+	require.Equal(t, true, ok)
+	return ruonia, err
+}
+
+// directRuonia is the response of CBR WS to the whole range without cache.
+func directRuonia(t *testing.T, fromDate string, toDate string) datastructures.RuoniaXMLResult {
+	t.Helper()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &historicalConfigMock{}, &ruoniaRangeSenderMock{}, appMemcache, nil)
+	ruonia, err := processRuonia(t, testApp, fromDate, toDate)
+	require.NoError(t, err)
+	return ruonia
+}
+
+func TestDateRangeCache(t *testing.T) {
+	t.Parallel()
+	initRangeTestApp := func(t *testing.T) (*app.App, *ruoniaRangeSenderMock) {
+		t.Helper()
+		loggerMock, err := mocks.NewLoggerMock(false)
+		require.NoError(t, err)
+		senderMock := ruoniaRangeSenderMock{}
+		appMemcache := memcache.New()
+		appMemcache.Init()
+		return app.New(loggerMock, &historicalConfigMock{}, &senderMock, appMemcache, nil), &senderMock
+	}
+	t.Run("TestDateRangeCache: OverlappingRanges", func(t *testing.T) {
+		t.Parallel()
+		testApp, senderMock := initRangeTestApp(t)
+		ruonia, err := processRuonia(t, testApp, "2023-01-09", "2023-01-31")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-09", "2023-01-31"), ruonia)
+		ruonia, err = processRuonia(t, testApp, "2023-01-21", "2023-02-15")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-21", "2023-02-15"), ruonia)
+		require.Equal(t, []string{"2023-01-09..2023-01-31", "2023-02-01..2023-02-15"}, senderMock.getRequests())
+
+		// days inside cached ranges, weekends between the days with data are cached as empty
+		ruonia, err = processRuonia(t, testApp, "2023-01-14", "2023-02-05")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-14", "2023-02-05"), ruonia)
+		ruonia, err = processRuonia(t, testApp, "2023-01-10", "2023-01-10")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-10", "2023-01-10"), ruonia)
+		require.Equal(t, 2, len(senderMock.getRequests()))
+	})
+	t.Run("TestDateRangeCache: MissingSubRanges", func(t *testing.T) {
+		t.Parallel()
+		testApp, senderMock := initRangeTestApp(t)
+		_, err := processRuonia(t, testApp, "2023-01-10", "2023-01-12")
+		require.NoError(t, err)
+		_, err = processRuonia(t, testApp, "2023-01-20", "2023-01-22")
+		require.NoError(t, err)
+		ruonia, err := processRuonia(t, testApp, "2023-01-09", "2023-01-24")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-09", "2023-01-24"), ruonia)
+		// weekend after the last day with data is requested again: its data can be not published yet
+		require.Equal(t, []string{
+			"2023-01-10..2023-01-12", "2023-01-20..2023-01-22",
+			"2023-01-09..2023-01-09", "2023-01-13..2023-01-19", "2023-01-21..2023-01-24",
+		}, senderMock.getRequests())
+	})
+	t.Run("TestDateRangeCache: TooManySubRanges", func(t *testing.T) {
+		t.Parallel()
+		testApp, senderMock := initRangeTestApp(t)
+		for _, day := range []string{"2023-01-10", "2023-01-12", "2023-01-17", "2023-01-19"} {
+			_, err := processRuonia(t, testApp, day, day)
+			require.NoError(t, err)
+		}
+		ruonia, err := processRuonia(t, testApp, "2023-01-09", "2023-01-20")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-09", "2023-01-20"), ruonia)
+		require.Equal(t, "2023-01-09..2023-01-20", senderMock.getRequests()[4])
+	})
+	t.Run("TestDateRangeCache: Error", func(t *testing.T) {
+		t.Parallel()
+		testApp, senderMock := initRangeTestApp(t)
+		_, err := processRuonia(t, testApp, "2023-01-09", "2023-01-13")
+		require.NoError(t, err)
+		senderMock.setErr(&customsoap.HTTPStatusError{StatusCode: 500, Status: "500 Internal Server Error"})
+		ruonia, err := processRuonia(t, testApp, "2023-01-09", "2023-01-20")
+		require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+		require.Equal(t, datastructures.RuoniaXMLResult{}, ruonia)
+		ruonia, err = processRuonia(t, testApp, "2023-01-10", "2023-01-12")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-10", "2023-01-12"), ruonia)
+	})
+	t.Run("TestDateRangeCache: DescendingOrder", func(t *testing.T) {
+		t.Parallel()
+		loggerMock, err := mocks.NewLoggerMock(false)
+		require.NoError(t, err)
+		senderMock := staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><SwapDayTotalXMLResponse xmlns="http://web.cbr.ru/"><SwapDayTotalXMLResult><SwapDayTotal xmlns=""><SDT><DT>2022-02-28T00:00:00Z</DT><Swap>0.0</Swap></SDT><SDT><DT>2022-02-25T00:00:00Z</DT><Swap>24120.4</Swap></SDT></SwapDayTotal></SwapDayTotalXMLResult></SwapDayTotalXMLResponse></soap:Body></soap:Envelope>`)}
+		appMemcache := memcache.New()
+		appMemcache.Init()
+		testApp := app.New(loggerMock, &historicalConfigMock{}, &senderMock, appMemcache, nil)
+		expected := datastructures.SwapDayTotalXMLResult{SDT: []datastructures.SwapDayTotalXMLResultElem{
+			{DT: time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC), Swap: "0.0"},
+			{DT: time.Date(2022, time.February, 25, 0, 0, 0, 0, time.UTC), Swap: "24120.4"},
+		}}
+		response, err := testApp.ProcessMethod(context.Background(), "SwapDayTotalXML", &datastructures.SwapDayTotalXML{FromDate: "2022-02-25", ToDate: "2022-02-28"})
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+		// the range is merged from cached days in the order of CBR WS
+		response, err = testApp.ProcessMethod(context.Background(), "SwapDayTotalXML", &datastructures.SwapDayTotalXML{FromDate: "2022-02-24", ToDate: "2022-02-28"})
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+	t.Run("TestDateRangeCache: WithoutCache", func(t *testing.T) {
+		t.Parallel()
+		testApp, senderMock := initRangeTestApp(t)
+		_, err := processRuonia(t, testApp, "2023-01-09", "2023-01-20")
+		require.NoError(t, err)
+		// cached days of the range are removed too, the range is not rebuilt from them
+		err = testApp.RemoveMethodDataInCache(context.Background(), "RuoniaXML", []byte(`{"FromDate":"2023-01-09","ToDate":"2023-01-20"}`))
+		require.NoError(t, err)
+		ruonia, err := processRuonia(t, testApp, "2023-01-09", "2023-01-20")
+		require.NoError(t, err)
+		require.Equal(t, directRuonia(t, "2023-01-09", "2023-01-20"), ruonia)
+		require.Equal(t, []string{"2023-01-09..2023-01-20", "2023-01-09..2023-01-20"}, senderMock.getRequests())
+	})
+}
+
+func TestCacheAdmin(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := keyRateSenderMock{rate: "7.50"}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &mocks.ConfigMock{}, &senderMock, appMemcache, nil)
+
+	_, _, err = processKeyRate(t, testApp)
+	require.NoError(t, err)
+	_, _, err = processKeyRate(t, testApp)
+	require.NoError(t, err)
+	_, _, err = testApp.ProcessMethodWithCacheInfo(context.Background(), "KeyRateXML", &datastructures.KeyRateXML{FromDate: "2023-06-20", ToDate: "2023-06-21"})
+	require.NoError(t, err)
+	cursOnDate := datastructures.GetCursOnDateXMLResult{OnDate: "20230622"}
+	testApp.Appmemcache.AddOrUpdatePayloadInCache(`GetCursOnDateXML{"On_date":"2023-06-22"}`, cursOnDate)
+
+	// KeyRateXML is cached by days too: data of 2023-06-22 and empty 2023-06-20, 2023-06-21 before it
+	entries, err := testApp.GetCacheEntries(app.CacheFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	require.Equal(t, "GetCursOnDateXML", entries[0].Method)
+	for i := 1; i < len(entries); i++ {
+		require.Equal(t, "KeyRateXML", entries[i].Method)
+	}
+	require.Less(t, entries[1].Key, entries[2].Key)
+	for _, entry := range entries {
+		require.NotNil(t, entry.ExpiresAt)
+		require.Equal(t, false, entry.Expired)
+		require.Positive(t, entry.SizeBytes)
+		require.Nil(t, entry.Payload)
+	}
+	entries, err = testApp.GetCacheEntries(app.CacheFilter{Method: "KeyRateXML"})
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	_, err = testApp.GetCacheEntries(app.CacheFilter{Method: "UnknownXML"})
+	require.ErrorIs(t, err, app.ErrMethodNotFound)
+
+	entry, err := testApp.GetCacheEntry(`GetCursOnDateXML{"On_date":"2023-06-22"}`)
+	require.NoError(t, err)
+	require.Equal(t, cursOnDate, entry.Payload)
+	_, err = testApp.GetCacheEntry("UnknownXML")
+	require.ErrorIs(t, err, app.ErrCacheEntryNotFound)
+
+	stats := testApp.GetCacheStats()
+	require.Equal(t, 5, stats.Entries)
+	require.Positive(t, stats.SizeBytes)
+	require.Equal(t, app.CacheRequestStats{Hits: 1, Misses: 2}, stats.Total)
+	require.Equal(t, map[string]app.CacheRequestStats{"KeyRateXML": {Hits: 1, Misses: 2}}, stats.Methods)
+
+	evicted, err := testApp.EvictCacheEntries(app.CacheFilter{Prefix: entries[0].Key})
+	require.NoError(t, err)
+	require.Equal(t, 1, evicted)
+	evicted, err = testApp.EvictCacheEntries(app.CacheFilter{Method: "GetCursOnDateXML"})
+	require.NoError(t, err)
+	require.Equal(t, 1, evicted)
+	require.Equal(t, 3, testApp.FlushCache())
+	entries, err = testApp.GetCacheEntries(app.CacheFilter{})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestCacheSnapshot(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := keyRateSenderMock{rate: "7.50"}
+	sourceMemcache := memcache.New()
+	sourceMemcache.Init()
+	sourceApp := app.New(loggerMock, &mocks.ConfigMock{}, &senderMock, sourceMemcache, nil)
+	_, _, err = processKeyRate(t, sourceApp)
+	require.NoError(t, err)
+
+	var snapshot bytes.Buffer
+	// the range and its day with data
+	exported, err := sourceApp.ExportCacheSnapshot(&snapshot, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, exported)
+
+	targetMemcache := memcache.New()
+	targetMemcache.Init()
+	targetApp := app.New(loggerMock, &mocks.ConfigMock{}, &senderMock, targetMemcache, nil)
+	imported, err := targetApp.ImportCacheSnapshot(&snapshot)
+	require.NoError(t, err)
+	require.Equal(t, 2, imported)
+	rate, cacheInfo, err := processKeyRate(t, targetApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.50", rate)
+	require.Equal(t, app.CacheHit, cacheInfo.Status)
+	require.Equal(t, 1, senderMock.getCalls())
+}
+
+func TestWarmUp(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	senderMock := keyRateSenderMock{rate: "7.50"}
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &mocks.ConfigMock{}, &senderMock, appMemcache, nil)
+
+	require.ErrorIs(t, testApp.ValidateWarmUp("UnknownMethod", nil), app.ErrMethodNotFound)
+	require.ErrorIs(t, testApp.ValidateWarmUp("GetLatestDateTime", nil), app.ErrMethodNotCached)
+	require.ErrorIs(t, testApp.ValidateWarmUp("KeyRateXML", []byte(`{"FromDate":"22.06.2023","ToDate":"2023-06-23"}`)), datastructures.ErrBadRawData)
+	require.ErrorIs(t, testApp.ValidateWarmUp("KeyRateXML", []byte(`{"FromDate":`)), datastructures.ErrBadRawData)
+	require.Equal(t, 0, senderMock.getCalls())
+
+	request := []byte(`{"FromDate":"2023-06-22","ToDate":"2023-06-23"}`)
+	require.NoError(t, testApp.ValidateWarmUp("KeyRateXML", request))
+	require.NoError(t, testApp.WarmUp(context.Background(), "KeyRateXML", request))
+	require.Equal(t, 1, senderMock.getCalls())
+	rate, cacheInfo, err := processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.50", rate)
+	require.Equal(t, app.CacheHit, cacheInfo.Status)
+	require.Equal(t, 1, senderMock.getCalls())
+
+	// warm-up refreshes fresh cached data
+	senderMock.set("7.75", nil)
+	require.NoError(t, testApp.WarmUp(context.Background(), "KeyRateXML", request))
+	require.Equal(t, 2, senderMock.getCalls())
+	rate, cacheInfo, err = processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.75", rate)
+	require.Equal(t, app.CacheHit, cacheInfo.Status)
+
+	senderMock.set("", &customsoap.HTTPStatusError{StatusCode: 503, Status: "503 Service Unavailable"})
+	require.Error(t, testApp.WarmUp(context.Background(), "KeyRateXML", request))
+	rate, _, err = processKeyRate(t, testApp)
+	require.NoError(t, err)
+	require.Equal(t, "7.75", rate)
+}
+
+type cachePolicyConfigMock struct {
+	mocks.ConfigMock
+	policies map[string]app.CachePolicy
+}
+
+func (config *cachePolicyConfigMock) GetCachePolicy(methodName string) app.CachePolicy {
+	return config.policies[methodName]
+}
+
+func TestLoadCachePolicies(t *testing.T) {
+	t.Parallel()
+	policies, err := app.LoadCachePolicies("")
+	require.NoError(t, err)
+	require.Nil(t, policies)
+
+	filePath := filepath.Join(t.TempDir(), "cachepolicies.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"NewsInfoXML":{"ttl":"1h"},"EnumValutesXML":{"ttl":"8760h","maxEntries":2},"KeyRateXML":{"bypass":true}}`), 0o600))
+	policies, err = app.LoadCachePolicies(filePath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]app.CachePolicy{
+		"NewsInfoXML":    {TTL: time.Hour},
+		"EnumValutesXML": {TTL: 8760 * time.Hour, MaxEntries: 2},
+		"KeyRateXML":     {Bypass: true},
+	}, policies)
+	require.NoError(t, app.ValidateCachePolicies(policies))
+
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"NewsInfoXML":{"ttl":"hour"}}`), 0o600))
+	_, err = app.LoadCachePolicies(filePath)
+	require.ErrorIs(t, err, app.ErrBadCachePolicy)
+
+	tests := []struct {
+		name     string
+		policies map[string]app.CachePolicy
+		err      error
+	}{
+		{name: "unknown method", policies: map[string]app.CachePolicy{"UnknownMethod": {TTL: time.Hour}}, err: app.ErrMethodNotFound},
+		{name: "not cached method", policies: map[string]app.CachePolicy{"GetLatestDateTime": {TTL: time.Hour}}, err: app.ErrMethodNotCached},
+		{name: "negative ttl", policies: map[string]app.CachePolicy{"NewsInfoXML": {TTL: -time.Hour}}, err: app.ErrBadCachePolicy},
+		{name: "negative max entries", policies: map[string]app.CachePolicy{"NewsInfoXML": {MaxEntries: -1}}, err: app.ErrBadCachePolicy},
+		{name: "bypass with ttl", policies: map[string]app.CachePolicy{"NewsInfoXML": {TTL: time.Hour, Bypass: true}}, err: app.ErrBadCachePolicy},
+	}
+	for _, tc := range tests {
+		require.ErrorIs(t, app.ValidateCachePolicies(tc.policies), tc.err, tc.name)
+	}
+}
+
+func TestCachePolicies(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+
+	t.Run("TestCachePolicies: Bypass", func(t *testing.T) {
+		t.Parallel()
+		senderMock := keyRateSenderMock{rate: "7.50"}
+		appMemcache := memcache.New()
+		appMemcache.Init()
+		config := &cachePolicyConfigMock{policies: map[string]app.CachePolicy{"KeyRateXML": {Bypass: true}}}
+		testApp := app.New(loggerMock, config, &senderMock, appMemcache, nil)
+		for i := 1; i <= 2; i++ {
+			rate, cacheInfo, err := processKeyRate(t, testApp)
+			require.NoError(t, err)
+			require.Equal(t, "7.50", rate)
+			require.Equal(t, app.CacheMiss, cacheInfo.Status)
+			require.Equal(t, i, senderMock.getCalls())
+		}
+		require.Len(t, appMemcache.GetAllCacheEntries(), 0)
+		require.ErrorIs(t, testApp.ValidateWarmUp("KeyRateXML", []byte(`{"FromDate":"2023-06-22","ToDate":"2023-06-23"}`)), app.ErrMethodNotCached)
+	})
+
+	t.Run("TestCachePolicies: TTLAndMaxEntries", func(t *testing.T) {
+		t.Parallel()
+		appMemcache := memcache.New()
+		appMemcache.Init()
+		config := &cachePolicyConfigMock{policies: map[string]app.CachePolicy{"SwapDynamicXML": {TTL: time.Hour, MaxEntries: 2}}}
+		senderMock := staticSenderMock{body: []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><SwapDynamicXMLResponse xmlns="http://web.cbr.ru/"><SwapDynamicXMLResult><SwapDynamic xmlns=""><Swap><DateBuy>2022-02-25T00:00:00Z</DateBuy><DateSell>2022-02-28T00:00:00Z</DateSell><BaseRate>96.8252</BaseRate><SD>0.0882</SD><TIR>10.5000</TIR><Stavka>-0.576000</Stavka><Currency>1</Currency></Swap></SwapDynamic></SwapDynamicXMLResult></SwapDynamicXMLResponse></soap:Body></soap:Envelope>`)}
+		testApp := app.New(loggerMock, config, &senderMock, appMemcache, nil)
+		// not historical dates, the policy TTL is applied to not historical data
+		dates := make([]string, 0, 3)
+		for day := 1; day <= 3; day++ {
+			date := datastructures.InputDate(time.Now().AddDate(0, 0, day))
+			dates = append(dates, date)
+			_, _, err := testApp.ProcessMethodWithCacheInfo(context.Background(), "SwapDynamicXML", &datastructures.SwapDynamicXML{FromDate: date, ToDate: date})
+			require.NoError(t, err)
+			time.Sleep(time.Millisecond)
+		}
+		// data of other methods expires by INFO_EXPIR_TIME
+		appMemcache.AddOrUpdatePayloadInCache("EnumValutesXML", datastructures.EnumValutesXMLResult{})
+		entries, err := testApp.GetCacheEntries(app.CacheFilter{Method: "SwapDynamicXML"})
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, entries[0].CachedAt.Add(time.Hour), *entries[0].ExpiresAt)
+
+		require.Equal(t, 2, testApp.CleanCache(time.Now().Add(time.Minute)))
+		entries, err = testApp.GetCacheEntries(app.CacheFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Contains(t, entries[0].Key, dates[1])
+		require.Contains(t, entries[1].Key, dates[2])
+
+		require.Equal(t, 2, testApp.CleanCache(time.Now().Add(2*time.Hour)))
+		require.Len(t, appMemcache.GetAllCacheEntries(), 0)
+	})
+}
+
+func TestGenerateTagForMemCacheLogic(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	appMemcache := memcache.New()
+	appMemcache.Init()
+	testApp := app.New(loggerMock, &mocks.ConfigMock{}, &keyRateSenderMock{rate: "7.50"}, appMemcache, nil)
+	request1 := &datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+	request2 := &datastructures.KeyRateXML{FromDate: "2023-06-21", ToDate: "2023-06-23"}
+	for _, request := range []*datastructures.KeyRateXML{request1, request2} {
+		_, err = testApp.ProcessMethod(context.Background(), "KeyRateXML", request)
+		require.NoError(t, err)
+	}
+	tag1 := getTagForCache(t, "KeyRateXML", request1)
+	tag2 := getTagForCache(t, "KeyRateXML", request2)
+	require.NotEqual(t, tag1, tag2)
+	_, ok := testApp.Appmemcache.GetCacheDataInCache(tag1)
+	require.Equal(t, true, ok)
+	_, ok = testApp.Appmemcache.GetCacheDataInCache(tag2)
+	require.Equal(t, true, ok)
+
+	require.NoError(t, testApp.RemoveMethodDataInCache(context.Background(), "KeyRateXML", []byte(`{"FromDate":"2023-06-22","ToDate":"2023-06-23"}`)))
+	_, ok = testApp.Appmemcache.GetCacheDataInCache(tag1)
+	require.Equal(t, false, ok)
+	_, ok = testApp.Appmemcache.GetCacheDataInCache(tag2)
 	require.Equal(t, true, ok)
-	require.Equal(t, testStruct2.Field3, data2)
 }
 
 func createStandartTestCacheCases(t *testing.T, input interface{}, output interface{}) []AppTestCase {
@@ -133,14 +1175,11 @@ func createStandartTestCacheCases(t *testing.T, input interface{}, output interf
 	return standartTestCacheCases
 }
 
-func getTagForCache(t *testing.T, SOAPMethod string, request interface{}) string { //nolint: gocritic
+func getTagForCache(t *testing.T, methodName string, request interface{}) string {
 	t.Helper()
-	if SOAPMethod == "Repo_debtXML" {
-		SOAPMethod = "RepoDebtXML" // fix changing name real SOAP method and Handlename TODO
-	}
 	jsonstring, err := json.Marshal(request)
 	require.NoError(t, err)
-	return SOAPMethod + string(jsonstring)
+	return methodName + string(jsonstring)
 }
 
 // GetCursOnDate.
@@ -148,7 +1187,6 @@ func initTestDataGetCursOnDateXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataGetCursOnDate := AppTestTable{
 		MethodName: "GetCursOnDateXML",
-		Method:     (*app.App).GetCursOnDateXML,
 	}
 	testGetCursOnDateXMLResult := datastructures.GetCursOnDateXMLResult{
 		OnDate:           "20230622",
@@ -203,7 +1241,6 @@ func initTestDataBiCurBaseXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataBiCurBaseXML := AppTestTable{
 		MethodName: "BiCurBaseXML",
-		Method:     (*app.App).BiCurBaseXML,
 	}
 	testBiCurBaseXMLResult := datastructures.BiCurBaseXMLResult{
 		BCB: make([]datastructures.BiCurBaseXMLResultElem, 2),
@@ -254,7 +1291,6 @@ func initTestDataBliquidityXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataBliquidityXML := AppTestTable{
 		MethodName: "BliquidityXML",
-		Method:     (*app.App).BliquidityXML,
 	}
 	testBliquidityXMLResult := datastructures.BliquidityXMLResult{
 		BL: make([]datastructures.BliquidityXMLResultElem, 2),
@@ -324,7 +1360,6 @@ func initTestDataDepoDynamicXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataDepoDynamicXML := AppTestTable{
 		MethodName: "DepoDynamicXML",
-		Method:     (*app.App).DepoDynamicXML,
 	}
 	testDepoDynamicXMLResult := datastructures.DepoDynamicXMLResult{
 		Depo: make([]datastructures.DepoDynamicXMLResultElem, 2),
@@ -374,7 +1409,6 @@ func initTestDragMetDynamicXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataDragMetDynamicXML := AppTestTable{
 		MethodName: "DragMetDynamicXML",
-		Method:     (*app.App).DragMetDynamicXML,
 	}
 	testDragMetDynamicXMLResult := datastructures.DragMetDynamicXMLResult{
 		DrgMet: make([]datastructures.DragMetDynamicXMLResultElem, 8),
@@ -462,7 +1496,6 @@ func initTestDataDVXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataDVXML := AppTestTable{
 		MethodName: "DVXML",
-		Method:     (*app.App).DVXML,
 	}
 	testDVXMLResult := datastructures.DVXMLResult{
 		DV: make([]datastructures.DVXMLResultElem, 2),
@@ -522,7 +1555,6 @@ func initTestDataEnumReutersValutesXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataEnumReutersValutesXML := AppTestTable{
 		MethodName: "EnumReutersValutesXML",
-		MethodWP:   (*app.App).EnumReutersValutesXML,
 		IsMethodWP: true,
 	}
 	testEnumReutersValutesXMLResult := datastructures.EnumReutersValutesXMLResult{
@@ -562,7 +1594,6 @@ func initTestDataEnumValutesXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataEnumValutesXML := AppTestTable{
 		MethodName: "EnumValutesXML",
-		Method:     (*app.App).EnumValutesXML,
 	}
 	testEnumValutesXMLResult := datastructures.EnumValutesXMLResult{
 		EnumValutes: make([]datastructures.EnumValutesXMLResultElem, 2),
@@ -610,7 +1641,6 @@ func initTestDataKeyRateXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataDVXML := AppTestTable{
 		MethodName: "KeyRateXML",
-		Method:     (*app.App).KeyRateXML,
 	}
 	testKeyRateXMLResult := datastructures.KeyRateXMLResult{
 		KR: make([]datastructures.KeyRateXMLResultElem, 2),
@@ -661,7 +1691,6 @@ func initTestDataMainInfoXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataMainInfoXML := AppTestTable{
 		MethodName: "MainInfoXML",
-		MethodWP:   (*app.App).MainInfoXML,
 		IsMethodWP: true,
 	}
 	testMainInfoXMLResult := datastructures.MainInfoXMLResult{
@@ -706,7 +1735,6 @@ func initTestDataMrrf7DXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataMrrf7DXML := AppTestTable{
 		MethodName: "mrrf7DXML",
-		Method:     (*app.App).Mrrf7DXML,
 	}
 	testMrrf7DXMLResult := datastructures.Mrrf7DXMLResult{
 		Mr: make([]datastructures.Mrrf7DXMLResultElem, 2),
@@ -757,7 +1785,6 @@ func initTestDataMrrfXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataMrrfXML := AppTestTable{
 		MethodName: "mrrfXML",
-		Method:     (*app.App).MrrfXML,
 	}
 
 	testMrrfXMLResult := datastructures.MrrfXMLResult{
@@ -819,7 +1846,6 @@ func initTestDataNewsInfoXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataNewsInfoXML := AppTestTable{
 		MethodName: "NewsInfoXML",
-		Method:     (*app.App).NewsInfoXML,
 	}
 
 	testNewsInfoXMLResult := datastructures.NewsInfoXMLResult{
@@ -875,7 +1901,6 @@ func initTestDataOmodInfoXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataOmodInfoXML := AppTestTable{
 		MethodName: "OmodInfoXML",
-		MethodWP:   (*app.App).OmodInfoXML,
 		IsMethodWP: true,
 	}
 	testOmodInfoXMLResult := datastructures.OmodInfoXMLResult{
@@ -935,7 +1960,6 @@ func initTestDataOstatDepoNewXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataOstatDepoNewXML := AppTestTable{
 		MethodName: "OstatDepoNewXML",
-		Method:     (*app.App).OstatDepoNewXML,
 	}
 	testOstatDepoNewXMLResult := datastructures.OstatDepoNewXMLResult{
 		Odn: make([]datastructures.OstatDepoNewXMLResultElem, 2),
@@ -990,7 +2014,6 @@ func initTestDataOstatDepoXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataOstatDepoXML := AppTestTable{
 		MethodName: "OstatDepoXML",
-		Method:     (*app.App).OstatDepoXML,
 	}
 	testOstatDepoXMLResult := datastructures.OstatDepoXMLResult{
 		Odr: make([]datastructures.OstatDepoXMLResultElem, 2),
@@ -1045,7 +2068,6 @@ func initTestDataOstatDynamicXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataOstatDynamicXML := AppTestTable{
 		MethodName: "OstatDynamicXML",
-		Method:     (*app.App).OstatDynamicXML,
 	}
 	testOstatDynamicXMLResult := datastructures.OstatDynamicXMLResult{
 		Ostat: make([]datastructures.OstatDynamicXMLResultElem, 2),
@@ -1098,7 +2120,6 @@ func initTestDataOvernightXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataOvernightXML := AppTestTable{
 		MethodName: "OvernightXML",
-		Method:     (*app.App).OvernightXML,
 	}
 	testOvernightXMLResult := datastructures.OvernightXMLResult{
 		OB: make([]datastructures.OvernightXMLResultElem, 2),
@@ -1148,8 +2169,7 @@ func initTestDataOvernightXML(t *testing.T) AppTestTable {
 func initTestDataRepo_debtXML(t *testing.T) AppTestTable { //nolint:revive, stylecheck, nolintlint
 	t.Helper()
 	testDataORepo_debtXML := AppTestTable{ //nolint:revive, stylecheck, nolintlint
-		MethodName: "Repo_debtXML",
-		Method:     (*app.App).RepoDebtXML,
+		MethodName: "RepoDebtXML",
 	}
 	testRepo_debtXMLResult := datastructures.Repo_debtXMLResult{ //nolint:revive, stylecheck, nolintlint
 		RD: make([]datastructures.Repo_debtXMLResultElem, 2),
@@ -1204,7 +2224,6 @@ func initTestDataRepoDebtUSDXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataRepoDebtUSDXML := AppTestTable{
 		MethodName: "RepoDebtUSDXML",
-		Method:     (*app.App).RepoDebtUSDXML,
 	}
 	testRepoDebtUSDXMLResult := datastructures.RepoDebtUSDXMLResult{
 		Rd: make([]datastructures.RepoDebtUSDXMLResultElem, 4),
@@ -1265,7 +2284,6 @@ func initTestDataROISfixXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataROISfixXML := AppTestTable{
 		MethodName: "ROISfixXML",
-		Method:     (*app.App).ROISfixXML,
 	}
 	testROISfixXMLResult := datastructures.ROISfixXMLResult{
 		Rf: make([]datastructures.ROISfixXMLResultElem, 2),
@@ -1326,7 +2344,6 @@ func initTestDataRuoniaSVXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataRuoniaSVXML := AppTestTable{
 		MethodName: "RuoniaSVXML",
-		Method:     (*app.App).RuoniaSVXML,
 	}
 	testRuoniaSVXMLResult := datastructures.RuoniaSVXMLResult{
 		Ra: make([]datastructures.RuoniaSVXMLResultElem, 2),
@@ -1383,7 +2400,6 @@ func initTestDataRuoniaXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataRuoniaXML := AppTestTable{
 		MethodName: "RuoniaXML",
-		Method:     (*app.App).RuoniaXML,
 	}
 	testRuoniaXMLResult := datastructures.RuoniaXMLResult{
 		Ro: make([]datastructures.RuoniaXMLResultElem, 2),
@@ -1438,7 +2454,6 @@ func initTestDataSaldoXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSaldoXML := AppTestTable{
 		MethodName: "SaldoXML",
-		Method:     (*app.App).SaldoXML,
 	}
 	testSaldoXMLResult := datastructures.SaldoXMLResult{
 		So: make([]datastructures.SaldoXMLResultElem, 2),
@@ -1489,7 +2504,6 @@ func initTestDataSwapDayTotalXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapDayTotalXML := AppTestTable{
 		MethodName: "SwapDayTotalXML",
-		Method:     (*app.App).SwapDayTotalXML,
 	}
 	testSwapDayTotalXMLResult := datastructures.SwapDayTotalXMLResult{
 		SDT: make([]datastructures.SwapDayTotalXMLResultElem, 2),
@@ -1540,7 +2554,6 @@ func initTestDataSwapDynamicXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapDynamicXML := AppTestTable{
 		MethodName: "SwapDynamicXML",
-		Method:     (*app.App).SwapDynamicXML,
 	}
 	testSwapDynamicXMLResult := datastructures.SwapDynamicXMLResult{
 		Swap: make([]datastructures.SwapDynamicXMLResultElem, 2),
@@ -1601,7 +2614,6 @@ func initTestDataSwapInfoSellUSDVolXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapInfoSellUSDVolXML := AppTestTable{
 		MethodName: "SwapInfoSellUSDVolXML",
-		Method:     (*app.App).SwapInfoSellUSDVolXML,
 	}
 	testSwapInfoSellUSDVolXMLResult := datastructures.SwapInfoSellUSDVolXMLResult{
 		SSUV: make([]datastructures.SwapInfoSellUSDVolXMLResultElem, 2),
@@ -1658,7 +2670,6 @@ func initTestDataSwapInfoSellUSDXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapInfoSellUSDXML := AppTestTable{
 		MethodName: "SwapInfoSellUSDXML",
-		Method:     (*app.App).SwapInfoSellUSDXML,
 	}
 	testSwapInfoSellUSDXMLResult := datastructures.SwapInfoSellUSDXMLResult{
 		SSU: make([]datastructures.SwapInfoSellUSDXMLResultElem, 2),
@@ -1723,7 +2734,6 @@ func initTestDataSwapInfoSellVolXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapInfoSellVolXML := AppTestTable{
 		MethodName: "SwapInfoSellVolXML",
-		Method:     (*app.App).SwapInfoSellVolXML,
 	}
 	testSwapInfoSellVolXMLResult := datastructures.SwapInfoSellVolXMLResult{
 		SSUV: make([]datastructures.SwapInfoSellVolXMLResultElem, 2),
@@ -1780,7 +2790,6 @@ func initTestDataSwapInfoSellXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapInfoSellXML := AppTestTable{
 		MethodName: "SwapInfoSellXML",
-		Method:     (*app.App).SwapInfoSellXML,
 	}
 	testSwapInfoSellXMLResult := datastructures.SwapInfoSellXMLResult{
 		SSU: make([]datastructures.SwapInfoSellXMLResultElem, 2),
@@ -1847,7 +2856,6 @@ func initTestDataSwapMonthTotalXML(t *testing.T) AppTestTable {
 	t.Helper()
 	testDataSwapMonthTotalXML := AppTestTable{
 		MethodName: "SwapMonthTotalXML",
-		Method:     (*app.App).SwapMonthTotalXML,
 	}
 	testSwapMonthTotalXMLResult := datastructures.SwapMonthTotalXMLResult{
 		SMT: make([]datastructures.SwapMonthTotalXMLResultElem, 2),
@@ -1900,7 +2908,6 @@ func initTestDataAllDataInfoXML(t *testing.T) AppTestTable { //nolint:gocognit,
 	t.Helper()
 	testDataAllDataInfoXML := AppTestTable{
 		MethodName: "AllDataInfoXML",
-		MethodWP:   (*app.App).AllDataInfoXML,
 		IsMethodWP: true,
 	}
 	testAllDataInfoXMLResult := datastructures.AllDataInfoXMLResult{
@@ -2355,9 +3362,460 @@ func initTestDataAllDataInfoXML(t *testing.T) AppTestTable { //nolint:gocognit,
 	return testDataAllDataInfoXML
 }
 
+// GetCursDynamicXML.
+func initTestDataGetCursDynamicXML(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetCursDynamicXML := AppTestTable{
+		MethodName: "GetCursDynamicXML",
+	}
+	testGetCursDynamicXMLResult := datastructures.GetCursDynamicXMLResult{
+		ValuteCursDynamic: make([]datastructures.GetCursDynamicXMLResultElem, 2),
+	}
+	testGetCursDynamicXMLResultElem := datastructures.GetCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01235",
+		Vnom:      1,
+		Vcurs:     "84.2467",
+		VunitRate: "84.2467",
+	}
+	testGetCursDynamicXMLResult.ValuteCursDynamic[0] = testGetCursDynamicXMLResultElem
+	testGetCursDynamicXMLResultElem = datastructures.GetCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01235",
+		Vnom:      1,
+		Vcurs:     "83.9499",
+		VunitRate: "83.9499",
+	}
+	testGetCursDynamicXMLResult.ValuteCursDynamic[1] = testGetCursDynamicXMLResultElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.GetCursDynamicXML{
+			FromDate:   "2023-06-22",
+			ToDate:     "2023-06-23",
+			ValutaCode: "R01235",
+		},
+		Output: testGetCursDynamicXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.GetCursDynamicXML{
+			FromDate:   "022-14-22",
+			ToDate:     "2023-06-23",
+			ValutaCode: "R01235",
+		},
+		Output: datastructures.GetCursDynamicXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.GetCursDynamicXML{
+		FromDate:   "2023-06-22",
+		ToDate:     "2023-06-23",
+		ValutaCode: "R01235",
+	}, testGetCursDynamicXMLResult)
+	testDataGetCursDynamicXML.TestCases = testCases
+	testDataGetCursDynamicXML.TestCases = append(testDataGetCursDynamicXML.TestCases, standartTestCacheCases...)
+
+	return testDataGetCursDynamicXML
+}
+
+// Coins_baseXML.
+func initTestDataCoins_baseXML(t *testing.T) AppTestTable { //nolint:revive, stylecheck, nolintlint
+	t.Helper()
+	testDataCoins_baseXML := AppTestTable{ //nolint:revive, stylecheck, nolintlint
+		MethodName: "CoinsBaseXML",
+	}
+	testCoins_baseXMLResult := datastructures.Coins_baseXMLResult{ //nolint:revive, stylecheck, nolintlint
+		CB: make([]datastructures.Coins_baseXMLResultElem, 2),
+	}
+	testCoins_baseXMLElem := datastructures.Coins_baseXMLResultElem{ //nolint:revive, stylecheck, nolintlint
+		Date:       time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		Cat_number: "5111-0178",
+		Price:      "48000.00",
+	}
+	testCoins_baseXMLResult.CB[0] = testCoins_baseXMLElem
+	testCoins_baseXMLElem = datastructures.Coins_baseXMLResultElem{
+		Date:       time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		Cat_number: "5111-0178",
+		Price:      "47500.00",
+	}
+	testCoins_baseXMLResult.CB[1] = testCoins_baseXMLElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.Coins_baseXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+		},
+		Output: testCoins_baseXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.Coins_baseXML{
+			FromDate: "022-14-22",
+			ToDate:   "2023-06-23",
+		},
+		Output: datastructures.Coins_baseXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.Coins_baseXML{
+		FromDate: "2023-06-22",
+		ToDate:   "2023-06-23",
+	}, testCoins_baseXMLResult)
+	testDataCoins_baseXML.TestCases = testCases
+	testDataCoins_baseXML.TestCases = append(testDataCoins_baseXML.TestCases, standartTestCacheCases...)
+
+	return testDataCoins_baseXML
+}
+
+// FixingBaseXML.
+func initTestDataFixingBaseXML(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataFixingBaseXML := AppTestTable{
+		MethodName: "FixingBaseXML",
+	}
+	testFixingBaseXMLResult := datastructures.FixingBaseXMLResult{
+		FB: make([]datastructures.FixingBaseXMLResultElem, 2),
+	}
+	testFixingBaseXMLResultElem := datastructures.FixingBaseXMLResultElem{
+		D0:     time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		CodMet: "1",
+		Price:  "5231.5400",
+	}
+	testFixingBaseXMLResult.FB[0] = testFixingBaseXMLResultElem
+	testFixingBaseXMLResultElem = datastructures.FixingBaseXMLResultElem{
+		D0:     time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		CodMet: "1",
+		Price:  "5180.1100",
+	}
+	testFixingBaseXMLResult.FB[1] = testFixingBaseXMLResultElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.FixingBaseXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+		},
+		Output: testFixingBaseXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.FixingBaseXML{
+			FromDate: "022-14-22",
+			ToDate:   "2023-06-23",
+		},
+		Output: datastructures.FixingBaseXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.FixingBaseXML{
+		FromDate: "2023-06-22",
+		ToDate:   "2023-06-23",
+	}, testFixingBaseXMLResult)
+	testDataFixingBaseXML.TestCases = testCases
+	testDataFixingBaseXML.TestCases = append(testDataFixingBaseXML.TestCases, standartTestCacheCases...)
+
+	return testDataFixingBaseXML
+}
+
+// GetReutersCursOnDateXML.
+func initTestDataGetReutersCursOnDateXML(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetReutersCursOnDateXML := AppTestTable{
+		MethodName: "GetReutersCursOnDateXML",
+	}
+	testGetReutersCursOnDateXMLResult := datastructures.GetReutersCursOnDateXMLResult{
+		OnDate:   "20230622",
+		Currency: make([]datastructures.GetReutersCursOnDateXMLResultElem, 2),
+	}
+	testGetReutersCursOnDateXMLResultElem := datastructures.GetReutersCursOnDateXMLResultElem{
+		Num_code: 8,
+		Val:      "0.8454",
+		Dir:      1,
+	}
+	testGetReutersCursOnDateXMLResult.Currency[0] = testGetReutersCursOnDateXMLResultElem
+	testGetReutersCursOnDateXMLResultElem = datastructures.GetReutersCursOnDateXMLResultElem{
+		Num_code: 12,
+		Val:      "0.6222",
+		Dir:      1,
+	}
+	testGetReutersCursOnDateXMLResult.Currency[1] = testGetReutersCursOnDateXMLResultElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.GetReutersCursOnDateXML{
+			OnDate: "2023-06-22",
+		},
+		Output: testGetReutersCursOnDateXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.GetReutersCursOnDateXML{
+			OnDate: "023-14-22",
+		},
+		Output: datastructures.GetReutersCursOnDateXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.GetReutersCursOnDateXML{
+		OnDate: "2023-06-22",
+	}, testGetReutersCursOnDateXMLResult)
+	testDataGetReutersCursOnDateXML.TestCases = testCases
+	testDataGetReutersCursOnDateXML.TestCases = append(testDataGetReutersCursOnDateXML.TestCases, standartTestCacheCases...)
+
+	return testDataGetReutersCursOnDateXML
+}
+
+// GetLatestDateTime.
+func initTestDataGetLatestDateTime(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetLatestDateTime := AppTestTable{
+		MethodName:  "GetLatestDateTime",
+		IsMethodWP:  true,
+		IsNotCached: true,
+	}
+	testCases := make([]AppTestCase, 1)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Output: datastructures.LatestDateTimeResult{
+			LatestDateTime: "2023-06-23T00:00:00",
+		},
+		Error: nil,
+	}
+	testDataGetLatestDateTime.TestCases = testCases
+
+	return testDataGetLatestDateTime
+}
+
+// GetLatestDateTimeSeld.
+func initTestDataGetLatestDateTimeSeld(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetLatestDateTimeSeld := AppTestTable{
+		MethodName:  "GetLatestDateTimeSeld",
+		IsMethodWP:  true,
+		IsNotCached: true,
+	}
+	testCases := make([]AppTestCase, 1)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Output: datastructures.LatestDateTimeResult{
+			LatestDateTime: "2023-06-01T00:00:00",
+		},
+		Error: nil,
+	}
+	testDataGetLatestDateTimeSeld.TestCases = testCases
+
+	return testDataGetLatestDateTimeSeld
+}
+
+// GetLatestReutersDateTime.
+func initTestDataGetLatestReutersDateTime(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetLatestReutersDateTime := AppTestTable{
+		MethodName:  "GetLatestReutersDateTime",
+		IsMethodWP:  true,
+		IsNotCached: true,
+	}
+	testCases := make([]AppTestCase, 1)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Output: datastructures.LatestDateTimeResult{
+			LatestDateTime: "2023-06-22T00:00:00",
+		},
+		Error: nil,
+	}
+	testDataGetLatestReutersDateTime.TestCases = testCases
+
+	return testDataGetLatestReutersDateTime
+}
+
+// MKRXML.
+func initTestDataMKRXML(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataMKRXML := AppTestTable{
+		MethodName: "MKRXML",
+	}
+	testMKRXMLResult := datastructures.MKRXMLResult{
+		MKR: make([]datastructures.MKRXMLResultElem, 2),
+	}
+	testMKRXMLResultElem := datastructures.MKRXMLResultElem{
+		CDate: time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		P1:    "1",
+		D1:    "7.25",
+		D7:    "7.40",
+		D30:   "7.66",
+		D90:   "7.93",
+		D180:  "8.17",
+		D360:  "8.53",
+	}
+	testMKRXMLResult.MKR[0] = testMKRXMLResultElem
+	testMKRXMLResultElem = datastructures.MKRXMLResultElem{
+		CDate: time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		P1:    "1",
+		D1:    "7.27",
+		D7:    "7.41",
+		D30:   "7.67",
+		D90:   "7.95",
+		D180:  "8.20",
+		D360:  "8.56",
+	}
+	testMKRXMLResult.MKR[1] = testMKRXMLResultElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.MKRXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+		},
+		Output: testMKRXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.MKRXML{
+			FromDate: "022-14-22",
+			ToDate:   "2023-06-23",
+		},
+		Output: datastructures.MKRXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.MKRXML{
+		FromDate: "2023-06-22",
+		ToDate:   "2023-06-23",
+	}, testMKRXMLResult)
+	testDataMKRXML.TestCases = testCases
+	testDataMKRXML.TestCases = append(testDataMKRXML.TestCases, standartTestCacheCases...)
+
+	return testDataMKRXML
+}
+
+// GetSeldCursDynamicXML.
+func initTestDataGetSeldCursDynamicXML(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetSeldCursDynamicXML := AppTestTable{
+		MethodName: "GetSeldCursDynamicXML",
+	}
+	testGetSeldCursDynamicXMLResult := datastructures.GetSeldCursDynamicXMLResult{
+		ValuteCursDynamic: make([]datastructures.GetSeldCursDynamicXMLResultElem, 2),
+	}
+	testGetSeldCursDynamicXMLResultElem := datastructures.GetSeldCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01030",
+		Vnom:      100,
+		Vcurs:     "91.2345",
+		VunitRate: "0.912345",
+	}
+	testGetSeldCursDynamicXMLResult.ValuteCursDynamic[0] = testGetSeldCursDynamicXMLResultElem
+	testGetSeldCursDynamicXMLResultElem = datastructures.GetSeldCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01030",
+		Vnom:      100,
+		Vcurs:     "92.3456",
+		VunitRate: "0.923456",
+	}
+	testGetSeldCursDynamicXMLResult.ValuteCursDynamic[1] = testGetSeldCursDynamicXMLResultElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.GetSeldCursDynamicXML{
+			FromDate:   "2023-05-01",
+			ToDate:     "2023-06-01",
+			ValutaCode: "R01030",
+		},
+		Output: testGetSeldCursDynamicXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.GetSeldCursDynamicXML{
+			FromDate:   "022-14-22",
+			ToDate:     "2023-06-01",
+			ValutaCode: "R01030",
+		},
+		Output: datastructures.GetSeldCursDynamicXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.GetSeldCursDynamicXML{
+		FromDate:   "2023-05-01",
+		ToDate:     "2023-06-01",
+		ValutaCode: "R01030",
+	}, testGetSeldCursDynamicXMLResult)
+	testDataGetSeldCursDynamicXML.TestCases = testCases
+	testDataGetSeldCursDynamicXML.TestCases = append(testDataGetSeldCursDynamicXML.TestCases, standartTestCacheCases...)
+
+	return testDataGetSeldCursDynamicXML
+}
+
+// GetSeldCursOnDateXML.
+func initTestDataGetSeldCursOnDateXML(t *testing.T) AppTestTable {
+	t.Helper()
+	testDataGetSeldCursOnDateXML := AppTestTable{
+		MethodName: "GetSeldCursOnDateXML",
+	}
+	testGetSeldCursOnDateXMLResult := datastructures.GetSeldCursOnDateXMLResult{
+		OnDate:           "20230601",
+		ValuteCursOnDate: make([]datastructures.GetSeldCursOnDateXMLResultElem, 2),
+	}
+	testGetSeldCursOnDateXMLResultElem := datastructures.GetSeldCursOnDateXMLResultElem{
+		Vname:   "Афганский афгани",
+		Vnom:    100,
+		Vcurs:   "92.3456",
+		Vcode:   "971",
+		VchCode: "AFN",
+	}
+	testGetSeldCursOnDateXMLResult.ValuteCursOnDate[0] = testGetSeldCursOnDateXMLResultElem
+	testGetSeldCursOnDateXMLResultElem = datastructures.GetSeldCursOnDateXMLResultElem{
+		Vname:   "Алжирский динар",
+		Vnom:    100,
+		Vcurs:   "59.1234",
+		Vcode:   "12",
+		VchCode: "DZD",
+	}
+	testGetSeldCursOnDateXMLResult.ValuteCursOnDate[1] = testGetSeldCursOnDateXMLResultElem
+
+	testCases := make([]AppTestCase, 2)
+	testCases[0] = AppTestCase{
+		Name: "Positive",
+		Input: &datastructures.GetSeldCursOnDateXML{
+			OnDate: "2023-06-01",
+		},
+		Output: testGetSeldCursOnDateXMLResult,
+		Error:  nil,
+	}
+
+	testCases[1] = AppTestCase{
+		Name: "Negative",
+		Input: &datastructures.GetSeldCursOnDateXML{
+			OnDate: "023-14-22",
+		},
+		Output: datastructures.GetSeldCursOnDateXMLResult{},
+		Error:  customsoap.ErrContextWSReqExpired,
+	}
+	standartTestCacheCases := createStandartTestCacheCases(t, &datastructures.GetSeldCursOnDateXML{
+		OnDate: "2023-06-01",
+	}, testGetSeldCursOnDateXMLResult)
+	testDataGetSeldCursOnDateXML.TestCases = testCases
+	testDataGetSeldCursOnDateXML.TestCases = append(testDataGetSeldCursOnDateXML.TestCases, standartTestCacheCases...)
+
+	return testDataGetSeldCursOnDateXML
+}
+
 func TestAllAppCases(t *testing.T) { //nolint:gocognit, nolintlint, gocyclo, funlen
 	acTable := AllCasesTable{}
-	acTable.CasesByMethod = make([]AppTestTable, 32)
+	acTable.CasesByMethod = make([]AppTestTable, 42)
 	acTable.CasesByMethod[0] = initTestDataGetCursOnDateXML(t)
 	acTable.CasesByMethod[1] = initTestDataBiCurBaseXML(t)
 	acTable.CasesByMethod[2] = initTestDataBliquidityXML(t)
@@ -2390,6 +3848,16 @@ func TestAllAppCases(t *testing.T) { //nolint:gocognit, nolintlint, gocyclo, fun
 	acTable.CasesByMethod[29] = initTestDataSwapInfoSellXML(t)
 	acTable.CasesByMethod[30] = initTestDataSwapMonthTotalXML(t)
 	acTable.CasesByMethod[31] = initTestDataAllDataInfoXML(t)
+	acTable.CasesByMethod[32] = initTestDataGetCursDynamicXML(t)
+	acTable.CasesByMethod[33] = initTestDataCoins_baseXML(t)
+	acTable.CasesByMethod[34] = initTestDataFixingBaseXML(t)
+	acTable.CasesByMethod[35] = initTestDataGetReutersCursOnDateXML(t)
+	acTable.CasesByMethod[36] = initTestDataGetLatestDateTime(t)
+	acTable.CasesByMethod[37] = initTestDataGetLatestDateTimeSeld(t)
+	acTable.CasesByMethod[38] = initTestDataGetLatestReutersDateTime(t)
+	acTable.CasesByMethod[39] = initTestDataMKRXML(t)
+	acTable.CasesByMethod[40] = initTestDataGetSeldCursDynamicXML(t)
+	acTable.CasesByMethod[41] = initTestDataGetSeldCursOnDateXML(t)
 	t.Parallel()
 	for _, curMethodTable := range acTable.CasesByMethod {
 		curMethodTable := curMethodTable
@@ -2399,21 +3867,18 @@ func TestAllAppCases(t *testing.T) { //nolint:gocognit, nolintlint, gocyclo, fun
 				t.Parallel()
 				var testRes interface{}
 				var cachedData memcache.CacheInfo
-				var rawBody []byte
 				var err error
 				var ok bool
 				testApp := initTestApp(t)
 				if !curMethodTable.IsMethodWP {
-					rawBody, err = json.Marshal(curTestCase.Input)
-					require.NoError(t, err)
-					testRes, err = curMethodTable.Method(testApp, context.Background(), curTestCase.Input, string(rawBody))
+					testRes, err = testApp.ProcessMethod(context.Background(), curMethodTable.MethodName, curTestCase.Input)
 				} else {
-					testRes, err = curMethodTable.MethodWP(testApp, context.Background())
+					testRes, err = testApp.ProcessMethod(context.Background(), curMethodTable.MethodName, nil)
 					require.NoError(t, err)
 				}
-				if err == nil {
+				var cacheTag string
+				if err == nil && !curMethodTable.IsNotCached {
 					// testApp.Appmemcache.PrintAllCacheKeys()
-					var cacheTag string
 					if curMethodTable.IsMethodWP {
 						cacheTag = curMethodTable.MethodName
 					} else {
@@ -2428,7 +3893,13 @@ func TestAllAppCases(t *testing.T) { //nolint:gocognit, nolintlint, gocyclo, fun
 				} else {
 					checkCashLogic(t, testApp, &curMethodTable, &curTestCase, cachedData.InfoDTStamp)
 				}
-				testApp.RemoveDataInMemCacheBySOAPAction(curMethodTable.MethodName)
+				if cacheTag != "" {
+					rawBody, err := json.Marshal(curTestCase.Input)
+					require.NoError(t, err)
+					require.NoError(t, testApp.RemoveMethodDataInCache(context.Background(), curMethodTable.MethodName, rawBody))
+					_, ok = testApp.Appmemcache.GetCacheDataInCache(cacheTag)
+					require.Equal(t, false, ok)
+				}
 			})
 		}
 	}
@@ -2440,13 +3911,11 @@ func checkCashLogic(t *testing.T, testApp *app.App, methodTable *AppTestTable, t
 	if !testCase.IsCacheData {
 		time.Sleep(2 * time.Second)
 	}
-	rawBody, err := json.Marshal(testCase.Input)
-	require.NoError(t, err)
 	if methodTable.IsMethodWP {
-		_, err := methodTable.MethodWP(testApp, context.Background())
+		_, err := testApp.ProcessMethod(context.Background(), methodTable.MethodName, nil)
 		require.Equal(t, nil, err)
 	} else {
-		_, err := methodTable.Method(testApp, context.Background(), testCase.Input, string(rawBody))
+		_, err := testApp.ProcessMethod(context.Background(), methodTable.MethodName, testCase.Input)
 		require.Equal(t, nil, err)
 	}
 	if methodTable.IsMethodWP {
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+)
+
+var ErrCacheEntryNotFound = errors.New("cache entry not found")
+
+// CacheEntry describes cached data for cache administration, payload is only in the inspection of the entry.
+type CacheEntry struct {
+	Key        string      `json:"key"`
+	Method     string      `json:"method,omitempty"`
+	CachedAt   time.Time   `json:"cachedAt"`
+	AgeSeconds int64       `json:"ageSeconds"`
+	ExpiresAt  *time.Time  `json:"expiresAt,omitempty"`
+	Expired    bool        `json:"expired"`
+	Historical bool        `json:"historical"`
+	SizeBytes  int64       `json:"sizeBytes"`
+	Payload    interface{} `json:"payload,omitempty"`
+}
+
+// CacheFilter selects cache entries by method and key prefix, void filter selects all entries.
+type CacheFilter struct {
+	Method string
+	Prefix string
+}
+
+// CacheRequestStats counts responses of cached methods by their cache status.
+type CacheRequestStats struct {
+	Hits   int64 `json:"hits"`
+	Stale  int64 `json:"stale"`
+	Misses int64 `json:"misses"`
+}
+
+type CacheStats struct {
+	Entries   int                          `json:"entries"`
+	SizeBytes int64                        `json:"sizeBytes"`
+	Since     time.Time                    `json:"since"`
+	Total     CacheRequestStats            `json:"total"`
+	Methods   map[string]CacheRequestStats `json:"methods"`
+}
+
+// cacheRequestCounter counts responses of the service instance since its start.
+type cacheRequestCounter struct {
+	mu      sync.Mutex
+	since   time.Time
+	methods map[string]CacheRequestStats
+}
+
+func newCacheRequestCounter() *cacheRequestCounter {
+	return &cacheRequestCounter{
+		since:   time.Now(),
+		methods: make(map[string]CacheRequestStats),
+	}
+}
+
+func (crc *cacheRequestCounter) observe(methodName string, status string) {
+	crc.mu.Lock()
+	defer crc.mu.Unlock()
+	stats := crc.methods[methodName]
+	switch status {
+	case CacheHit:
+		stats.Hits++
+	case CacheStale:
+		stats.Stale++
+	default:
+		stats.Misses++
+	}
+	crc.methods[methodName] = stats
+}
+
+func (crc *cacheRequestCounter) stats() (time.Time, CacheRequestStats, map[string]CacheRequestStats) {
+	crc.mu.Lock()
+	defer crc.mu.Unlock()
+	var total CacheRequestStats
+	methods := make(map[string]CacheRequestStats, len(crc.methods))
+	for methodName, stats := range crc.methods {
+		methods[methodName] = stats
+		total.Hits += stats.Hits
+		total.Stale += stats.Stale
+		total.Misses += stats.Misses
+	}
+	return crc.since, total, methods
+}
+
+// cacheKeyMethod returns the method of the cache key, the key is the method name followed by JSON of the request, if it has params.
+func (a *App) cacheKeyMethod(key string) string {
+	for _, descriptor := range a.methods.GetMethods() {
+		if key == descriptor.Name || strings.HasPrefix(key, descriptor.Name+"{") {
+			return descriptor.Name
+		}
+	}
+	return ""
+}
+
+func (a *App) cacheEntry(entry memcache.EntryInfo, now time.Time) CacheEntry { //nolint: gocritic
+	cacheEntry := CacheEntry{
+		Key:        entry.Tag,
+		Method:     a.cacheKeyMethod(entry.Tag),
+		CachedAt:   entry.InfoDTStamp,
+		AgeSeconds: int64(now.Sub(entry.InfoDTStamp).Seconds()),
+		Historical: entry.Historical,
+		SizeBytes:  entry.Size,
+	}
+	expirDTStamp, expires := a.expirDTStamp(cacheEntry.Method, memcache.CacheInfo{InfoDTStamp: entry.InfoDTStamp, Historical: entry.Historical})
+	if expires {
+		cacheEntry.ExpiresAt = &expirDTStamp
+		cacheEntry.Expired = !expirDTStamp.After(now)
+	}
+	return cacheEntry
+}
+
+func (a *App) validateCacheFilter(filter CacheFilter) error {
+	if filter.Method == "" {
+		return nil
+	}
+	if _, ok := a.methods.GetMethod(filter.Method); !ok {
+		return fmt.Errorf("%w: %s", ErrMethodNotFound, filter.Method)
+	}
+	return nil
+}
+
+// GetCacheEntries returns cache entries selected by the filter sorted by keys.
+func (a *App) GetCacheEntries(filter CacheFilter) ([]CacheEntry, error) {
+	err := a.validateCacheFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	entries := make([]CacheEntry, 0)
+	for _, entry := range a.Appmemcache.GetAllCacheEntries() {
+		cacheEntry := a.cacheEntry(entry, now)
+		if filter.Method != "" && cacheEntry.Method != filter.Method || !strings.HasPrefix(entry.Tag, filter.Prefix) {
+			continue
+		}
+		entries = append(entries, cacheEntry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries, nil
+}
+
+// GetCacheEntry returns the cache entry with its payload, inspection does not change the cache and LRU order.
+func (a *App) GetCacheEntry(key string) (CacheEntry, error) {
+	cachedData, ok := a.Appmemcache.PeekCacheDataInCache(key)
+	if !ok {
+		return CacheEntry{}, fmt.Errorf("%w: %s", ErrCacheEntryNotFound, key)
+	}
+	cacheEntry := a.cacheEntry(memcache.EntryInfo{
+		Tag:         key,
+		InfoDTStamp: cachedData.InfoDTStamp,
+		Historical:  cachedData.Historical,
+		Size:        memcache.EntrySize(key, cachedData.Payload),
+	}, time.Now())
+	cacheEntry.Payload = cachedData.Payload
+	return cacheEntry, nil
+}
+
+// EvictCacheEntries removes cache entries selected by the filter and returns their number.
+func (a *App) EvictCacheEntries(filter CacheFilter) (int, error) {
+	entries, err := a.GetCacheEntries(filter)
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range entries {
+		a.Appmemcache.RemovePayloadInCache(entry.Key)
+	}
+	a.logger.Info(fmt.Sprintf("cache admin: %d entries evicted (method %q, prefix %q)", len(entries), filter.Method, filter.Prefix))
+	return len(entries), nil
+}
+
+// FlushCache removes all cache entries and returns their number.
+func (a *App) FlushCache() int {
+	evicted, _ := a.EvictCacheEntries(CacheFilter{})
+	return evicted
+}
+
+// GetCacheStats returns the size of cache and counts of responses by cache status since the start of the service.
+func (a *App) GetCacheStats() CacheStats {
+	var stats CacheStats
+	for _, entry := range a.Appmemcache.GetAllCacheEntries() {
+		stats.Entries++
+		stats.SizeBytes += entry.Size
+	}
+	stats.Since, stats.Total, stats.Methods = a.cacheRequests.stats()
+	return stats
+}
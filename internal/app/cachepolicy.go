@@ -0,0 +1,163 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+)
+
+var ErrBadCachePolicy = errors.New("bad cache policy")
+
+// CachePolicy overrides caching of the method: TTL of not historical data instead of INFO_EXPIR_TIME (zero is not override),
+// bypass of the cache and the limit of cached entries of the method (zero is unlimited), which is applied by the cache cleaner.
+type CachePolicy struct {
+	TTL        time.Duration
+	Bypass     bool
+	MaxEntries int
+}
+
+type cachePolicyJSON struct {
+	TTL        string `json:"ttl"`
+	Bypass     bool   `json:"bypass"`
+	MaxEntries int    `json:"maxEntries"`
+}
+
+// UnmarshalJSON parses the policy like {"ttl":"1h","bypass":false,"maxEntries":100}.
+func (cp *CachePolicy) UnmarshalJSON(data []byte) error {
+	var policy cachePolicyJSON
+	err := json.Unmarshal(data, &policy)
+	if err != nil {
+		return err
+	}
+	*cp = CachePolicy{Bypass: policy.Bypass, MaxEntries: policy.MaxEntries}
+	if policy.TTL != "" {
+		cp.TTL, err = time.ParseDuration(policy.TTL)
+		if err != nil {
+			return fmt.Errorf("%w: ttl %q", ErrBadCachePolicy, policy.TTL)
+		}
+	}
+	return nil
+}
+
+func (cp *CachePolicy) Validate() error {
+	if cp.TTL < 0 {
+		return fmt.Errorf("%w: negative ttl %s", ErrBadCachePolicy, cp.TTL)
+	}
+	if cp.MaxEntries < 0 {
+		return fmt.Errorf("%w: negative max entries %d", ErrBadCachePolicy, cp.MaxEntries)
+	}
+	if cp.Bypass && (cp.TTL != 0 || cp.MaxEntries != 0) {
+		return fmt.Errorf("%w: ttl or max entries of bypassed method", ErrBadCachePolicy)
+	}
+	return nil
+}
+
+// LoadCachePolicies reads JSON object of cache policies by method names, void path means no policies.
+func LoadCachePolicies(filePath string) (map[string]CachePolicy, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	policies := make(map[string]CachePolicy)
+	err = json.Unmarshal(data, &policies)
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// ValidateCachePolicies checks policies and that their methods are registered and cached.
+func ValidateCachePolicies(policies map[string]CachePolicy) error {
+	methods := datastructures.NewDefaultMethodRegistry()
+	for methodName, policy := range policies {
+		policy := policy
+		descriptor, ok := methods.GetMethod(methodName)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrMethodNotFound, methodName)
+		}
+		if descriptor.NotCached {
+			return fmt.Errorf("%w: %s", ErrMethodNotCached, methodName)
+		}
+		err := policy.Validate()
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodName, err)
+		}
+	}
+	return nil
+}
+
+// isCached reports whether data of the method is cached: it is not a service method and cache is not bypassed by its policy.
+func (a *App) isCached(descriptor datastructures.MethodDescriptor) bool { //nolint: gocritic
+	return !descriptor.NotCached && !a.config.GetCachePolicy(descriptor.Name).Bypass
+}
+
+// infoExpirTime is TTL of not historical data of the method.
+func (a *App) infoExpirTime(methodName string) time.Duration {
+	ttl := a.config.GetCachePolicy(methodName).TTL
+	if ttl > 0 {
+		return ttl
+	}
+	return a.config.GetInfoExpirTime()
+}
+
+// CleanCache removes data expired longer than stale windows ago, then the oldest entries of methods over their max entries.
+// It returns the number of removed entries.
+func (a *App) CleanCache(now time.Time) int {
+	staleWindow := a.maxStaleWindow()
+	maxInfoExpirTime := a.config.GetInfoExpirTime()
+	byPolicies := false
+	for _, descriptor := range a.methods.GetMethods() {
+		policy := a.config.GetCachePolicy(descriptor.Name)
+		if policy.TTL > 0 || policy.MaxEntries > 0 {
+			byPolicies = true
+		}
+		if policy.TTL > maxInfoExpirTime {
+			maxInfoExpirTime = policy.TTL
+		}
+	}
+	removed := a.Appmemcache.RemoveAllPayloadInCacheByTimeStamp(now.Add(-1 * (maxInfoExpirTime + staleWindow)))
+	historicalInfoExpirTime := a.config.GetHistoricalInfoExpirTime()
+	if historicalInfoExpirTime > 0 {
+		removed += a.Appmemcache.RemoveHistoricalPayloadInCacheByTimeStamp(now.Add(-1 * (historicalInfoExpirTime + staleWindow)))
+	}
+	if !byPolicies {
+		return removed
+	}
+
+	limitedEntries := make(map[string][]memcache.EntryInfo)
+	for _, entry := range a.Appmemcache.GetAllCacheEntries() {
+		methodName := a.cacheKeyMethod(entry.Tag)
+		if !entry.Historical && entry.InfoDTStamp.Before(now.Add(-1*(a.infoExpirTime(methodName)+staleWindow))) {
+			a.Appmemcache.RemovePayloadInCache(entry.Tag)
+			removed++
+			continue
+		}
+		if methodName != "" && a.config.GetCachePolicy(methodName).MaxEntries > 0 {
+			limitedEntries[methodName] = append(limitedEntries[methodName], entry)
+		}
+	}
+	for methodName, entries := range limitedEntries {
+		maxEntries := a.config.GetCachePolicy(methodName).MaxEntries
+		if len(entries) <= maxEntries {
+			continue
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].InfoDTStamp.After(entries[j].InfoDTStamp)
+		})
+		for _, entry := range entries[maxEntries:] {
+			a.Appmemcache.RemovePayloadInCache(entry.Tag)
+		}
+		removed += len(entries) - maxEntries
+		a.logger.Info(fmt.Sprintf("CacheCleaner: %d oldest entries of %s removed by max entries", len(entries)-maxEntries, methodName))
+	}
+	return removed
+}
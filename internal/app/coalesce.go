@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// CoalescedRequests counts requests, which got the result of an identical in-flight request instead of own CBR WS call.
+var CoalescedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "cbrwsdltojson",
+	Subsystem: "app",
+	Name:      "coalesced_requests_total",
+}, []string{"method"})
+
+type inFlightCall struct {
+	done     chan struct{}
+	response interface{}
+	err      error
+}
+
+// requestGroup coalesces identical in-flight requests: only the first one calls CBR WS, the others wait for its result.
+type requestGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inFlightCall
+}
+
+func newRequestGroup() *requestGroup {
+	return &requestGroup{calls: make(map[string]*inFlightCall)}
+}
+
+// Do runs fn once for all concurrent calls with the key, shared reports whether the result was got from another call.
+// The call runs with ctx of the first caller, every waiter stops waiting on its own ctx.
+func (rg *requestGroup) Do(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (response interface{}, shared bool, err error) {
+	rg.mu.Lock()
+	if call, ok := rg.calls[key]; ok {
+		rg.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, true, ErrContextWSReqExpired
+		case <-call.done:
+			return call.response, true, call.err
+		}
+	}
+	call := &inFlightCall{done: make(chan struct{})}
+	rg.calls[key] = call
+	rg.mu.Unlock()
+
+	defer func() {
+		rg.mu.Lock()
+		delete(rg.calls, key)
+		rg.mu.Unlock()
+		close(call.done)
+	}()
+	call.response, call.err = fn(ctx)
+	return call.response, false, call.err
+}
+
+// InFlight reports whether a call with the key is running.
+func (rg *requestGroup) InFlight(key string) bool {
+	rg.mu.Lock()
+	defer rg.mu.Unlock()
+	_, ok := rg.calls[key]
+	return ok
+}
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+)
+
+// maxRangeFetches limits CBR WS calls for one request, if more sub-ranges are missing, they are fetched by one call.
+const maxRangeFetches = 3
+
+type dateRange struct {
+	from time.Time
+	to   time.Time
+}
+
+// fetchAndCacheDateRange answers the request of the method with RangeDateField by cached days and requests CBR WS
+// only for missing sub-ranges. Every fetched day is cached under the key of one-day request, so overlapping
+// ranges and one-day requests share it, the merged result is cached under the key of the request.
+func (a *App) fetchAndCacheDateRange(ctx context.Context, descriptor datastructures.MethodDescriptor, request datastructures.RequestData, cacheKey string) (interface{}, error) { //nolint: gocritic
+	fromDate, toDate, ok := datastructures.RequestDateRange(request)
+	if !ok || toDate.Before(fromDate) {
+		return a.fetchAndCacheMethodData(ctx, descriptor, request, cacheKey)
+	}
+	emptyResponse := reflect.ValueOf(descriptor.NewResult()).Elem().Interface()
+	days := make(map[string]interface{})
+	missing := make([]dateRange, 0)
+	for day := fromDate; !day.After(toDate); day = day.AddDate(0, 0, 1) {
+		dayResponse, found := a.lookupRangeDay(descriptor, request, day, reflect.TypeOf(emptyResponse))
+		if found {
+			days[datastructures.InputDate(day)] = dayResponse
+			continue
+		}
+		last := len(missing) - 1
+		if last >= 0 && missing[last].to.AddDate(0, 0, 1).Equal(day) {
+			missing[last].to = day
+		} else {
+			missing = append(missing, dateRange{from: day, to: day})
+		}
+	}
+	if len(missing) > maxRangeFetches {
+		missing = []dateRange{{from: missing[0].from, to: missing[len(missing)-1].to}}
+	}
+	for _, subRange := range missing {
+		subRangeDays, err := a.fetchAndCacheRangeDays(ctx, descriptor, request, subRange)
+		if err != nil {
+			return emptyResponse, err
+		}
+		for day, dayResponse := range subRangeDays {
+			days[day] = dayResponse
+		}
+	}
+	return a.mergeAndCacheRangeDays(descriptor, request, cacheKey, dateRange{from: fromDate, to: toDate}, days)
+}
+
+// refreshDateRange requests CBR WS for the whole range of the request regardless of cached days and caches the result.
+func (a *App) refreshDateRange(ctx context.Context, descriptor datastructures.MethodDescriptor, request datastructures.RequestData, cacheKey string) (interface{}, error) { //nolint: gocritic
+	fromDate, toDate, ok := datastructures.RequestDateRange(request)
+	if !ok || toDate.Before(fromDate) {
+		return a.fetchAndCacheMethodData(ctx, descriptor, request, cacheKey)
+	}
+	fullRange := dateRange{from: fromDate, to: toDate}
+	days, err := a.fetchAndCacheRangeDays(ctx, descriptor, request, fullRange)
+	if err != nil {
+		return reflect.ValueOf(descriptor.NewResult()).Elem().Interface(), err
+	}
+	return a.mergeAndCacheRangeDays(descriptor, request, cacheKey, fullRange, days)
+}
+
+// mergeAndCacheRangeDays merges results of days of the range in the order of CBR WS and caches the merged result
+// under the key of the request.
+func (a *App) mergeAndCacheRangeDays(descriptor datastructures.MethodDescriptor, request datastructures.RequestData, cacheKey string, fullRange dateRange, days map[string]interface{}) (interface{}, error) { //nolint: gocritic
+	emptyResponse := reflect.ValueOf(descriptor.NewResult()).Elem().Interface()
+	parts := make([]interface{}, 0, len(days))
+	for day := fullRange.from; !day.After(fullRange.to); day = day.AddDate(0, 0, 1) {
+		dayResponse, ok := days[datastructures.InputDate(day)]
+		if ok {
+			parts = append(parts, dayResponse)
+		}
+	}
+	if descriptor.RangeDescending {
+		for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+			parts[i], parts[j] = parts[j], parts[i]
+		}
+	}
+	if len(parts) == 0 {
+		return emptyResponse, nil
+	}
+	response, err := datastructures.MergeResults(parts, descriptor.RangeDateField)
+	if err != nil {
+		return emptyResponse, err
+	}
+	a.cacheMethodData(descriptor, request, cacheKey, response)
+	return response, nil
+}
+
+// lookupRangeDay returns fresh cached data of the day of the request.
+func (a *App) lookupRangeDay(descriptor datastructures.MethodDescriptor, request datastructures.RequestData, day time.Time, responseType reflect.Type) (interface{}, bool) { //nolint: gocritic
+	dayKey, err := rangeDayCacheKey(descriptor, request, day)
+	if err != nil {
+		a.logger.Error(err.Error())
+		return nil, false
+	}
+	cached, found := a.lookupCache(descriptor, dayKey, responseType)
+	if !found || cached.Staleness > 0 {
+		return nil, false
+	}
+	return cached.Payload, true
+}
+
+// fetchAndCacheRangeDays requests CBR WS for the sub-range and caches its days. Day without data is cached as empty,
+// only if it is historical and there is data on a later day: data of the last days can be not published yet.
+func (a *App) fetchAndCacheRangeDays(ctx context.Context, descriptor datastructures.MethodDescriptor, request datastructures.RequestData, subRange dateRange) (map[string]interface{}, error) { //nolint: gocritic
+	subRequest, err := normalizedRequest(descriptor, request)
+	if err != nil {
+		return nil, err
+	}
+	datastructures.SetRequestDateRange(subRequest, subRange.from, subRange.to)
+	response, err := a.fetchMethodData(ctx, descriptor, subRequest)
+	if err != nil {
+		return nil, err
+	}
+	fetchedDays, err := datastructures.SplitResultByDay(response, descriptor.RangeDateField)
+	if err != nil {
+		return nil, err
+	}
+	lastDataDay := ""
+	for day := range fetchedDays {
+		if day > lastDataDay {
+			lastDataDay = day
+		}
+	}
+
+	days := make(map[string]interface{}, len(fetchedDays))
+	for day := subRange.from; !day.After(subRange.to); day = day.AddDate(0, 0, 1) {
+		dayKey := datastructures.InputDate(day)
+		historical := a.isHistoricalDate(descriptor, day)
+		dayResponse, ok := fetchedDays[dayKey]
+		if !ok {
+			if !historical || dayKey > lastDataDay {
+				continue
+			}
+			dayResponse = reflect.ValueOf(descriptor.NewResult()).Elem().Interface()
+		}
+		days[dayKey] = dayResponse
+		err = a.cacheRangeDay(descriptor, request, day, dayResponse, historical)
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+	}
+	return days, nil
+}
+
+func (a *App) cacheRangeDay(descriptor datastructures.MethodDescriptor, request datastructures.RequestData, day time.Time, dayResponse interface{}, historical bool) error { //nolint: gocritic
+	dayKey, err := rangeDayCacheKey(descriptor, request, day)
+	if err != nil {
+		return err
+	}
+	if historical {
+		a.Appmemcache.AddOrUpdateHistoricalPayloadInCache(dayKey, dayResponse)
+	} else {
+		a.Appmemcache.AddOrUpdatePayloadInCache(dayKey, dayResponse)
+	}
+	return nil
+}
+
+// removeRangeDays removes cached data of every day of the range of the request.
+func (a *App) removeRangeDays(descriptor datastructures.MethodDescriptor, request datastructures.RequestData) { //nolint: gocritic
+	fromDate, toDate, ok := datastructures.RequestDateRange(request)
+	if !ok {
+		return
+	}
+	for day := fromDate; !day.After(toDate); day = day.AddDate(0, 0, 1) {
+		dayKey, err := rangeDayCacheKey(descriptor, request, day)
+		if err != nil {
+			a.logger.Error(err.Error())
+			return
+		}
+		a.Appmemcache.RemovePayloadInCache(dayKey)
+	}
+}
+
+// rangeDayCacheKey is the cache key of the request with the range of one day.
+func rangeDayCacheKey(descriptor datastructures.MethodDescriptor, request datastructures.RequestData, day time.Time) (string, error) { //nolint: gocritic
+	dayRequest, err := normalizedRequest(descriptor, request)
+	if err != nil {
+		return "", err
+	}
+	datastructures.SetRequestDateRange(dayRequest, day, day)
+	return datastructures.CacheKey(descriptor.Name, dayRequest)
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+)
+
+var (
+	ErrUnknownAPIKey  = errors.New("unknown API key")
+	ErrAPIKeyRequired = errors.New("API key required")
+)
+
+type apiKeyCtxKey struct{}
+
+// WithAPIKey returns context with API key of the client, which is used to choose access policy.
+func WithAPIKey(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(ctx, apiKeyCtxKey{}, apiKey)
+}
+
+func APIKeyFromContext(ctx context.Context) string {
+	apiKey, _ := ctx.Value(apiKeyCtxKey{}).(string)
+	return apiKey
+}
+
+// AccessPolicy permits methods by allow and deny lists of patterns (syntax of path.Match, e.g. GetCurs*).
+// Deny list wins, void allow list permits all methods.
+// Pattern is matched with both handler name and SOAP method name (e.g. CoinsBaseXML and Coins_baseXML).
+type AccessPolicy struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+func (ap *AccessPolicy) Validate() error {
+	for _, pattern := range append(append([]string{}, ap.Allow...), ap.Deny...) {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("%w: %q", err, pattern)
+		}
+	}
+	return nil
+}
+
+func (ap *AccessPolicy) IsPermitted(names ...string) bool {
+	if matchAny(ap.Deny, names) {
+		return false
+	}
+	if len(ap.Allow) == 0 {
+		return true
+	}
+	return matchAny(ap.Allow, names)
+}
+
+func matchAny(patterns []string, names []string) bool {
+	for _, pattern := range patterns {
+		for _, name := range names {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AccessPolicies is the default policy for clients without API key and policies of API keys.
+// Policy of API key replaces the default one. If API key is required, clients without API key are rejected,
+// while policies of API keys are configured. It is read only after creation.
+type AccessPolicies struct {
+	defaultPolicy  AccessPolicy
+	apiKeyPolicies map[string]AccessPolicy
+	apiKeyRequired bool
+}
+
+func NewAccessPolicies(defaultPolicy AccessPolicy, apiKeyPolicies map[string]AccessPolicy, apiKeyRequired bool) (*AccessPolicies, error) {
+	err := defaultPolicy.Validate()
+	if err != nil {
+		return nil, err
+	}
+	for apiKey, policy := range apiKeyPolicies {
+		policy := policy
+		err = policy.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("API key %q: %w", apiKey, err)
+		}
+	}
+	return &AccessPolicies{
+		defaultPolicy:  defaultPolicy,
+		apiKeyPolicies: apiKeyPolicies,
+		apiKeyRequired: apiKeyRequired,
+	}, nil
+}
+
+// LoadAPIKeyPolicies reads JSON object of policies by API keys, void path means no API key policies.
+func LoadAPIKeyPolicies(filePath string) (map[string]AccessPolicy, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	policies := make(map[string]AccessPolicy)
+	err = json.Unmarshal(data, &policies)
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Check returns ErrMethodProhibited, ErrUnknownAPIKey or ErrAPIKeyRequired, if client can not call the method.
+// API key is ignored, if policies of API keys are not configured.
+func (aps *AccessPolicies) Check(apiKey string, descriptor datastructures.MethodDescriptor) error { //nolint: gocritic
+	if aps == nil {
+		return nil
+	}
+	policy := aps.defaultPolicy
+	if apiKey == "" && aps.apiKeyRequired && len(aps.apiKeyPolicies) > 0 {
+		return ErrAPIKeyRequired
+	}
+	if apiKey != "" && len(aps.apiKeyPolicies) > 0 {
+		apiKeyPolicy, ok := aps.apiKeyPolicies[apiKey]
+		if !ok {
+			return ErrUnknownAPIKey
+		}
+		policy = apiKeyPolicy
+	}
+	if !policy.IsPermitted(descriptor.Name, descriptor.SOAPMethod) {
+		return ErrMethodProhibited
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+)
+
+// cachePayloadTypes are result types of registered methods, only they are in snapshots.
+func (a *App) cachePayloadTypes() memcache.PayloadTypes {
+	descriptors := a.methods.GetMethods()
+	resultTypes := make([]reflect.Type, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		resultTypes = append(resultTypes, reflect.TypeOf(descriptor.NewResult()).Elem())
+	}
+	return memcache.NewPayloadTypes(resultTypes)
+}
+
+// ExportCacheSnapshot writes all cached data to the snapshot, gzip compressed if compress is true.
+func (a *App) ExportCacheSnapshot(w io.Writer, compress bool) (int, error) {
+	exported, err := memcache.ExportSnapshot(w, a.Appmemcache.Snapshot(), a.cachePayloadTypes(), compress)
+	if err != nil {
+		return exported, err
+	}
+	a.logger.Info(fmt.Sprintf("cache snapshot: %d entries exported", exported))
+	return exported, nil
+}
+
+// ImportCacheSnapshot restores cached data of the snapshot with its time stamps, so expired data is not fresh after import.
+func (a *App) ImportCacheSnapshot(r io.Reader) (int, error) {
+	imported, err := memcache.ImportSnapshot(r, a.Appmemcache, a.cachePayloadTypes())
+	if err != nil {
+		return imported, err
+	}
+	a.logger.Info(fmt.Sprintf("cache snapshot: %d entries imported", imported))
+	return imported, nil
+}
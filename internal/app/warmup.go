@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+)
+
+var ErrMethodNotCached = errors.New("method is not cached")
+
+// warmUpRequest returns the normalized and validated request of the cached method, raw body is JSON of the request as in the method handler.
+func (a *App) warmUpRequest(methodName string, rawBody []byte) (datastructures.MethodDescriptor, datastructures.RequestData, error) {
+	descriptor, ok := a.methods.GetMethod(methodName)
+	if !ok {
+		return descriptor, nil, fmt.Errorf("%w: %s", ErrMethodNotFound, methodName)
+	}
+	if !a.isCached(descriptor) {
+		return descriptor, nil, fmt.Errorf("%w: %s", ErrMethodNotCached, methodName)
+	}
+	input := descriptor.NewRequest()
+	if !descriptor.WithoutParams {
+		err := json.Unmarshal(rawBody, input)
+		if err != nil {
+			return descriptor, nil, fmt.Errorf("%w: %s", datastructures.ErrBadRawData, err.Error())
+		}
+		input.Init()
+		err = input.Validate()
+		if err != nil {
+			return descriptor, nil, err
+		}
+	}
+	request, err := normalizedRequest(descriptor, input)
+	return descriptor, request, err
+}
+
+// ValidateWarmUp checks, that the method is cached and the request is valid, without CBR WS call.
+func (a *App) ValidateWarmUp(methodName string, rawBody []byte) error {
+	_, _, err := a.warmUpRequest(methodName, rawBody)
+	return err
+}
+
+// WarmUp requests CBR WS and refreshes cached data of the method request regardless of cached data and API key policies.
+func (a *App) WarmUp(ctx context.Context, methodName string, rawBody []byte) error {
+	descriptor, request, err := a.warmUpRequest(methodName, rawBody)
+	if err != nil {
+		return err
+	}
+	cacheKey, err := datastructures.CacheKey(descriptor.Name, request)
+	if err != nil {
+		return err
+	}
+	a.CheckLatestDate(ctx, descriptor)
+	// cached days of ranges are fetched again too, data of them could be published after caching
+	_, shared, err := a.requests.Do(ctx, cacheKey, func(ctx context.Context) (interface{}, error) {
+		if descriptor.RangeDateField != "" {
+			return a.refreshDateRange(ctx, descriptor, request, cacheKey)
+		}
+		return a.fetchAndCacheMethodData(ctx, descriptor, request, cacheKey)
+	})
+	if shared {
+		CoalescedRequests.WithLabelValues(descriptor.Name).Inc()
+	}
+	return err
+}
@@ -0,0 +1,219 @@
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrBadConfig      = errors.New("bad cache cleaner config")
+	ErrAlreadyStarted = errors.New("cache cleaner is already started")
+)
+
+type Logger interface {
+	Info(msg string)
+	Warning(msg string)
+	Error(msg string)
+	Fatal(msg string)
+	GetZapLogger() *zap.SugaredLogger
+}
+
+// Cache removes expired data and returns the number of removed entries.
+type Cache interface {
+	CleanCache(now time.Time) int
+}
+
+// Clock is the source of time and timers of the cleaner, it is replaced by a fake clock in tests.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{Timer: time.NewTimer(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (rt realTimer) C() <-chan time.Time {
+	return rt.Timer.C
+}
+
+// Config: delay before every cleaning is random in [Interval*(1-Jitter), Interval*(1+Jitter)],
+// so cleanings of service instances with the shared cache are spread in time.
+type Config struct {
+	Interval time.Duration
+	Jitter   float64
+}
+
+func (c *Config) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("%w: interval %s is not positive", ErrBadConfig, c.Interval)
+	}
+	if c.Jitter < 0 || c.Jitter >= 1 {
+		return fmt.Errorf("%w: jitter %v out of range [0, 1)", ErrBadConfig, c.Jitter)
+	}
+	return nil
+}
+
+type Metrics struct {
+	Runs     prometheus.Counter
+	Removed  prometheus.Counter
+	Duration prometheus.Histogram
+	LastRun  prometheus.Gauge
+}
+
+func CreateMetrics() Metrics {
+	return Metrics{
+		Runs: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "cache_cleaner",
+			Name:      "runs_total",
+		}),
+		Removed: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "cache_cleaner",
+			Name:      "removed_entries_total",
+		}),
+		Duration: promauto.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "cache_cleaner",
+			Name:      "duration_seconds",
+		}),
+		LastRun: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "cache_cleaner",
+			Name:      "last_run_timestamp_seconds",
+		}),
+	}
+}
+
+// Cleaner periodically removes expired data of the cache in the background.
+type Cleaner struct {
+	logger  Logger
+	cache   Cache
+	config  Config
+	metrics Metrics
+	clock   Clock
+	// rand is used only by the goroutine of the started cleaner
+	rand *rand.Rand
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(logger Logger, cache Cache, config Config, metrics Metrics) (*Cleaner, error) {
+	return NewWithClock(logger, cache, config, metrics, realClock{})
+}
+
+func NewWithClock(logger Logger, cache Cache, config Config, metrics Metrics, clock Clock) (*Cleaner, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &Cleaner{
+		logger:  logger,
+		cache:   cache,
+		config:  config,
+		metrics: metrics,
+		clock:   clock,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}, nil
+}
+
+// Start runs cleanings until the context is done or the cleaner is stopped.
+func (c *Cleaner) Start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done != nil {
+		return ErrAlreadyStarted
+	}
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done = make(chan struct{})
+	go c.run(ctx, c.done)
+	c.logger.Info("CacheCleaner start")
+	return nil
+}
+
+// Stop stops the cleaner and waits for the end of the running cleaning, the stopped cleaner can be started again.
+func (c *Cleaner) Stop() {
+	c.mu.Lock()
+	cancel, done := c.cancel, c.done
+	c.cancel, c.done = nil, nil
+	c.mu.Unlock()
+	if done == nil {
+		return
+	}
+	cancel()
+	<-done
+	c.logger.Info("CacheCleaner stop")
+}
+
+func (c *Cleaner) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	for {
+		timer := c.clock.NewTimer(c.nextDelay())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+		c.Clean()
+	}
+}
+
+func (c *Cleaner) nextDelay() time.Duration {
+	spread := int64(float64(c.config.Interval) * c.config.Jitter)
+	if spread <= 0 {
+		return c.config.Interval
+	}
+	return c.config.Interval - time.Duration(spread) + time.Duration(c.rand.Int63n(2*spread+1))
+}
+
+// Clean removes expired data of the cache once and returns the number of removed entries.
+func (c *Cleaner) Clean() int {
+	start := c.clock.Now()
+	removed := c.cache.CleanCache(start)
+	duration := c.clock.Now().Sub(start)
+	c.observe(start, removed, duration)
+	c.logger.Info(fmt.Sprintf("CacheCleaner: %d entries removed in %s", removed, duration))
+	return removed
+}
+
+func (c *Cleaner) observe(start time.Time, removed int, duration time.Duration) {
+	if c.metrics.Runs != nil {
+		c.metrics.Runs.Inc()
+	}
+	if c.metrics.Removed != nil {
+		c.metrics.Removed.Add(float64(removed))
+	}
+	if c.metrics.Duration != nil {
+		c.metrics.Duration.Observe(duration.Seconds())
+	}
+	if c.metrics.LastRun != nil {
+		c.metrics.LastRun.Set(float64(start.Unix()))
+	}
+}
@@ -0,0 +1,206 @@
+package cleaner_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	cleaner "github.com/skolzkyi/cbrwsdltojson/internal/cleaner"
+	mocks "github.com/skolzkyi/cbrwsdltojson/internal/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second
+
+type fakeTimer struct {
+	clock   *fakeClock
+	at      time.Time
+	c       chan time.Time
+	stopped bool
+	fired   bool
+}
+
+func (ft *fakeTimer) C() <-chan time.Time {
+	return ft.c
+}
+
+func (ft *fakeTimer) Stop() bool {
+	ft.clock.mu.Lock()
+	defer ft.clock.mu.Unlock()
+	active := !ft.stopped && !ft.fired
+	ft.stopped = true
+	return active
+}
+
+// fakeClock fires timers only by Advance, delays of created timers are sent to the channel.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	created chan time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:     time.Date(2023, 6, 22, 10, 0, 0, 0, time.UTC),
+		created: make(chan time.Duration, 10),
+	}
+}
+
+func (fc *fakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+func (fc *fakeClock) NewTimer(d time.Duration) cleaner.Timer {
+	fc.mu.Lock()
+	timer := &fakeTimer{clock: fc, at: fc.now.Add(d), c: make(chan time.Time, 1)}
+	fc.timers = append(fc.timers, timer)
+	fc.mu.Unlock()
+	fc.created <- d
+	return timer
+}
+
+func (fc *fakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = fc.now.Add(d)
+	for _, timer := range fc.timers {
+		if !timer.stopped && !timer.fired && !timer.at.After(fc.now) {
+			timer.fired = true
+			timer.c <- fc.now
+		}
+	}
+}
+
+func (fc *fakeClock) waitTimer(t *testing.T) time.Duration {
+	t.Helper()
+	select {
+	case d := <-fc.created:
+		return d
+	case <-time.After(waitTimeout):
+		require.FailNow(t, "timer is not created")
+		return 0
+	}
+}
+
+type cacheMock struct {
+	removed int
+	cleaned chan time.Time
+}
+
+func (cm *cacheMock) CleanCache(now time.Time) int {
+	cm.cleaned <- now
+	return cm.removed
+}
+
+func (cm *cacheMock) requireCleaned(t *testing.T, at time.Time) {
+	t.Helper()
+	select {
+	case now := <-cm.cleaned:
+		require.True(t, at.Equal(now), now.String())
+	case <-time.After(waitTimeout):
+		require.FailNow(t, "cache is not cleaned")
+	}
+}
+
+func (cm *cacheMock) requireNotCleaned(t *testing.T) {
+	t.Helper()
+	select {
+	case now := <-cm.cleaned:
+		require.FailNow(t, "unexpected cleaning", now.String())
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func testMetrics() cleaner.Metrics {
+	return cleaner.Metrics{
+		Runs:     prometheus.NewCounter(prometheus.CounterOpts{Name: "runs_total"}),
+		Removed:  prometheus.NewCounter(prometheus.CounterOpts{Name: "removed_entries_total"}),
+		Duration: prometheus.NewHistogram(prometheus.HistogramOpts{Name: "duration_seconds"}),
+		LastRun:  prometheus.NewGauge(prometheus.GaugeOpts{Name: "last_run_timestamp_seconds"}),
+	}
+}
+
+func TestCleanerConfig(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	for _, config := range []cleaner.Config{{Interval: 0}, {Interval: time.Hour, Jitter: -0.1}, {Interval: time.Hour, Jitter: 1}} {
+		_, err = cleaner.New(loggerMock, &cacheMock{}, config, cleaner.Metrics{})
+		require.ErrorIs(t, err, cleaner.ErrBadConfig)
+	}
+}
+
+func TestCleaner(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+
+	t.Run("TestCleaner: JitteredIntervalsAndMetrics", func(t *testing.T) {
+		t.Parallel()
+		clock := newFakeClock()
+		cache := &cacheMock{removed: 3, cleaned: make(chan time.Time, 1)}
+		metrics := testMetrics()
+		cacheCleaner, err := cleaner.NewWithClock(loggerMock, cache, cleaner.Config{Interval: time.Hour, Jitter: 0.1}, metrics, clock)
+		require.NoError(t, err)
+		require.NoError(t, cacheCleaner.Start(context.Background()))
+		defer cacheCleaner.Stop()
+		require.ErrorIs(t, cacheCleaner.Start(context.Background()), cleaner.ErrAlreadyStarted)
+
+		delay := clock.waitTimer(t)
+		for run := 1; run <= 3; run++ {
+			require.GreaterOrEqual(t, delay, 54*time.Minute)
+			require.LessOrEqual(t, delay, 66*time.Minute)
+			clock.Advance(delay - time.Second)
+			cache.requireNotCleaned(t)
+			clock.Advance(time.Second)
+			cache.requireCleaned(t, clock.Now())
+			// the next timer is created after metrics of the cleaning
+			delay = clock.waitTimer(t)
+			require.Equal(t, float64(run), testutil.ToFloat64(metrics.Runs))
+			require.Equal(t, float64(3*run), testutil.ToFloat64(metrics.Removed))
+			require.Equal(t, float64(clock.Now().Unix()), testutil.ToFloat64(metrics.LastRun))
+		}
+	})
+
+	t.Run("TestCleaner: Stop", func(t *testing.T) {
+		t.Parallel()
+		clock := newFakeClock()
+		cache := &cacheMock{cleaned: make(chan time.Time, 1)}
+		cacheCleaner, err := cleaner.NewWithClock(loggerMock, cache, cleaner.Config{Interval: time.Hour}, cleaner.Metrics{}, clock)
+		require.NoError(t, err)
+		require.NoError(t, cacheCleaner.Start(context.Background()))
+		require.Equal(t, time.Hour, clock.waitTimer(t))
+		cacheCleaner.Stop()
+		cacheCleaner.Stop()
+		clock.Advance(time.Hour)
+		cache.requireNotCleaned(t)
+
+		// stopped cleaner can be started again
+		require.NoError(t, cacheCleaner.Start(context.Background()))
+		require.Equal(t, time.Hour, clock.waitTimer(t))
+		clock.Advance(time.Hour)
+		cache.requireCleaned(t, clock.Now())
+		cacheCleaner.Stop()
+	})
+
+	t.Run("TestCleaner: DoneContext", func(t *testing.T) {
+		t.Parallel()
+		clock := newFakeClock()
+		cache := &cacheMock{cleaned: make(chan time.Time, 1)}
+		cacheCleaner, err := cleaner.NewWithClock(loggerMock, cache, cleaner.Config{Interval: time.Hour}, cleaner.Metrics{}, clock)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, cacheCleaner.Start(ctx))
+		clock.waitTimer(t)
+		cancel()
+		cacheCleaner.Stop()
+		clock.Advance(time.Hour)
+		cache.requireNotCleaned(t)
+	})
+}
@@ -0,0 +1,231 @@
+package customsoap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// States of the circuit breaker, values are exported to Prometheus.
+const (
+	StateClosed   CircuitState = 0
+	StateOpen     CircuitState = 1
+	StateHalfOpen CircuitState = 2
+)
+
+var (
+	ErrCircuitOpen          = errors.New("circuit breaker of CBR WS is open")
+	ErrBadCircuitBreakerCfg = errors.New("bad circuit breaker config")
+)
+
+type CircuitState int
+
+func (cs CircuitState) String() string {
+	switch cs {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+func (cs CircuitState) MarshalText() ([]byte, error) {
+	return []byte(cs.String()), nil
+}
+
+// CircuitBreakerConfig: breaker opens after FailureThreshold consecutive failures, after OpenTimeout
+// lets HalfOpenMaxCalls probe calls through and closes when all of them succeed.
+type CircuitBreakerConfig struct {
+	FailureThreshold int
+	OpenTimeout      time.Duration
+	HalfOpenMaxCalls int
+}
+
+func (cbc *CircuitBreakerConfig) Validate() error {
+	if cbc.FailureThreshold < 1 || cbc.HalfOpenMaxCalls < 1 || cbc.OpenTimeout <= 0 {
+		return fmt.Errorf("%w: failure threshold %d, open timeout %s, half-open max calls %d", ErrBadCircuitBreakerCfg, cbc.FailureThreshold, cbc.OpenTimeout, cbc.HalfOpenMaxCalls)
+	}
+	return nil
+}
+
+type CircuitBreakerStatus struct {
+	State               CircuitState `json:"state"`
+	ConsecutiveFailures int          `json:"consecutiveFailures"`
+	FailureThreshold    int          `json:"failureThreshold"`
+	OpenedAt            *time.Time   `json:"openedAt,omitempty"`
+	RetryAt             *time.Time   `json:"retryAt,omitempty"`
+	LastError           string       `json:"lastError,omitempty"`
+}
+
+type CircuitBreakerMetrics struct {
+	State    prometheus.Gauge
+	Rejected prometheus.Counter
+}
+
+func CreateCircuitBreakerMetrics() CircuitBreakerMetrics {
+	return CircuitBreakerMetrics{
+		State: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "soap",
+			Name:      "circuit_breaker_state",
+			Help:      "0 - closed, 1 - open, 2 - half-open",
+		}),
+		Rejected: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "soap",
+			Name:      "circuit_breaker_rejected_total",
+		}),
+	}
+}
+
+// CircuitBreaker rejects calls of the wrapped sender with ErrCircuitOpen, while CBR WS is unavailable.
+type CircuitBreaker struct {
+	mu                  sync.Mutex
+	logger              Logger
+	next                SoapRequestSender
+	config              CircuitBreakerConfig
+	metrics             CircuitBreakerMetrics
+	state               CircuitState
+	consecutiveFailures int
+	halfOpenCalls       int
+	halfOpenSuccesses   int
+	openedAt            time.Time
+	lastError           string
+}
+
+func NewCircuitBreaker(logger Logger, next SoapRequestSender, config CircuitBreakerConfig, metrics CircuitBreakerMetrics) (*CircuitBreaker, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+	cb := CircuitBreaker{
+		logger:  logger,
+		next:    next,
+		config:  config,
+		metrics: metrics,
+	}
+	cb.setState(StateClosed)
+	return &cb, nil
+}
+
+// IsBreakerFailure reports whether the error means unavailability of CBR WS.
+// SOAP faults, client errors and canceled requests do not open the breaker.
+func IsBreakerFailure(err error) bool {
+	switch ErrorClass(err) {
+	case RetryOnNetwork, RetryOnTimeout, RetryOn5xx:
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+func (cb *CircuitBreaker) SoapCall(ctx context.Context, action string, payload interface{}) ([]byte, error) {
+	err := cb.before()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cb.next.SoapCall(ctx, action, payload)
+	cb.after(err)
+	return res, err
+}
+
+func (cb *CircuitBreaker) before() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == StateOpen && time.Since(cb.openedAt) >= cb.config.OpenTimeout {
+		cb.halfOpenCalls = 0
+		cb.halfOpenSuccesses = 0
+		cb.setState(StateHalfOpen)
+	}
+	switch cb.state {
+	case StateOpen:
+		cb.reject()
+		return ErrCircuitOpen
+	case StateHalfOpen:
+		if cb.halfOpenCalls >= cb.config.HalfOpenMaxCalls {
+			cb.reject()
+			return ErrCircuitOpen
+		}
+		cb.halfOpenCalls++
+	case StateClosed:
+	}
+	return nil
+}
+
+func (cb *CircuitBreaker) after(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	// the call did not reach CBR WS, so it tells nothing about its availability
+	if errors.Is(err, context.Canceled) || errors.Is(err, ErrContextWSReqExpired) {
+		if cb.state == StateHalfOpen && cb.halfOpenCalls > 0 {
+			cb.halfOpenCalls--
+		}
+		return
+	}
+	if err != nil && IsBreakerFailure(err) {
+		cb.lastError = err.Error()
+		cb.consecutiveFailures++
+		if cb.state == StateHalfOpen || cb.consecutiveFailures >= cb.config.FailureThreshold {
+			cb.open()
+		}
+		return
+	}
+	cb.consecutiveFailures = 0
+	if cb.state == StateHalfOpen {
+		cb.halfOpenSuccesses++
+		if cb.halfOpenSuccesses >= cb.config.HalfOpenMaxCalls {
+			cb.setState(StateClosed)
+			cb.logger.Info("circuit breaker of CBR WS is closed")
+		}
+	}
+}
+
+func (cb *CircuitBreaker) open() {
+	if cb.state != StateOpen {
+		cb.logger.Warning("circuit breaker of CBR WS is open: " + cb.lastError)
+	}
+	cb.openedAt = time.Now()
+	cb.setState(StateOpen)
+}
+
+func (cb *CircuitBreaker) setState(state CircuitState) {
+	cb.state = state
+	if cb.metrics.State != nil {
+		cb.metrics.State.Set(float64(state))
+	}
+}
+
+func (cb *CircuitBreaker) reject() {
+	if cb.metrics.Rejected != nil {
+		cb.metrics.Rejected.Inc()
+	}
+}
+
+func (cb *CircuitBreaker) Status() CircuitBreakerStatus {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	status := CircuitBreakerStatus{
+		State:               cb.state,
+		ConsecutiveFailures: cb.consecutiveFailures,
+		FailureThreshold:    cb.config.FailureThreshold,
+		LastError:           cb.lastError,
+	}
+	if cb.state == StateOpen && time.Since(cb.openedAt) >= cb.config.OpenTimeout {
+		status.State = StateHalfOpen
+	}
+	if cb.state != StateClosed {
+		openedAt := cb.openedAt
+		retryAt := cb.openedAt.Add(cb.config.OpenTimeout)
+		status.OpenedAt = &openedAt
+		status.RetryAt = &retryAt
+	}
+	return status
+}
@@ -18,8 +18,64 @@ import (
 var (
 	ErrBadLenEnvelopeSlice = errors.New("bad length of slice with element of envelope string")
 	ErrContextWSReqExpired = errors.New("context of request to CBR WS expired")
+	ErrBadHTTPStatus       = errors.New("CBR WS responded with bad HTTP status")
 )
 
+// HTTPStatusError is the non-2xx response of CBR WS, it matches ErrBadHTTPStatus.
+type HTTPStatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return ErrBadHTTPStatus.Error() + ": " + e.Status
+}
+
+func (e *HTTPStatusError) Unwrap() error {
+	return ErrBadHTTPStatus
+}
+
+// SOAPFault is the SOAP 1.1 fault returned by CBR WS.
+type SOAPFault struct {
+	Code       string
+	String     string
+	Detail     string
+	HTTPStatus int
+}
+
+func (f *SOAPFault) Error() string {
+	msg := "CBR WS SOAP fault " + f.Code + ": " + f.String
+	if f.Detail != "" {
+		msg += " (" + f.Detail + ")"
+	}
+	return msg
+}
+
+type soapFaultRS struct {
+	Code   string `xml:"Body>Fault>faultcode"`
+	String string `xml:"Body>Fault>faultstring"`
+	Detail struct {
+		InnerXML string `xml:",innerxml"`
+	} `xml:"Body>Fault>detail"`
+}
+
+// ParseSOAPFault returns fault of the SOAP envelope, if envelope contains it.
+func ParseSOAPFault(data []byte) (*SOAPFault, bool) {
+	if !bytes.Contains(data, []byte("Fault")) {
+		return nil, false
+	}
+	var rs soapFaultRS
+	err := xml.Unmarshal(data, &rs)
+	if err != nil || (rs.Code == "" && rs.String == "") {
+		return nil, false
+	}
+	return &SOAPFault{
+		Code:   strings.TrimSpace(rs.Code),
+		String: strings.TrimSpace(rs.String),
+		Detail: strings.TrimSpace(rs.Detail.InnerXML),
+	}, true
+}
+
 type CBRSOAPSender struct {
 	InclLogger Logger
 	InclConfig Config
@@ -45,7 +101,6 @@ type Config interface {
 	GetInfoExpirTime() time.Duration
 	GetCBRWSDLAddress() string
 	GetLoggingOn() bool
-	GetPermittedRequests() map[string]struct{}
 }
 
 type soapRQ struct {
@@ -129,6 +184,18 @@ func (soapSender *CBRSOAPSender) SoapCall(ctx context.Context, action string, pa
 			return nil, err
 		}
 
+		fault, ok := ParseSOAPFault(bodyBytes)
+		if ok {
+			fault.HTTPStatus = response.StatusCode
+			soapSender.InclLogger.Error(fault.Error())
+			return nil, fault
+		}
+		if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+			err = &HTTPStatusError{StatusCode: response.StatusCode, Status: response.Status}
+			soapSender.InclLogger.Error(err.Error())
+			return nil, err
+		}
+
 		return bodyBytes, nil
 	}
 }
@@ -0,0 +1,275 @@
+package customsoap_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	customsoap "github.com/skolzkyi/cbrwsdltojson/internal/customsoap"
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+	mocks "github.com/skolzkyi/cbrwsdltojson/internal/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const faultEnvelope = `<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><soap:Fault><faultcode>soap:Server</faultcode><faultstring>Server was unable to process request. ---&gt; Bad date</faultstring><detail><Reason>Bad date</Reason></detail></soap:Fault></soap:Body></soap:Envelope>`
+
+const keyRateEnvelope = `<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><KeyRateXMLResponse xmlns="http://web.cbr.ru/"><KeyRateXMLResult><KeyRate xmlns=""><KR><DT>2023-06-22T00:00:00+03:00</DT><Rate>7.50</Rate></KR></KeyRate></KeyRateXMLResult></KeyRateXMLResponse></soap:Body></soap:Envelope>`
+
+type testConfig struct {
+	mocks.ConfigMock
+	address string
+}
+
+func (config *testConfig) GetCBRWSDLAddress() string {
+	return config.address
+}
+
+func TestParseSOAPFault(t *testing.T) {
+	t.Parallel()
+	fault, ok := customsoap.ParseSOAPFault([]byte(faultEnvelope))
+	require.True(t, ok)
+	require.Equal(t, "soap:Server", fault.Code)
+	require.Equal(t, "Server was unable to process request. ---> Bad date", fault.String)
+	require.Equal(t, "<Reason>Bad date</Reason>", fault.Detail)
+
+	_, ok = customsoap.ParseSOAPFault([]byte(keyRateEnvelope))
+	require.False(t, ok)
+	_, ok = customsoap.ParseSOAPFault([]byte("Fault"))
+	require.False(t, ok)
+}
+
+func TestSoapCall(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		err        error
+	}{
+		{name: "Positive", statusCode: http.StatusOK, body: keyRateEnvelope},
+		{name: "SOAPFault", statusCode: http.StatusInternalServerError, body: faultEnvelope},
+		{name: "SOAPFaultWithStatusOK", statusCode: http.StatusOK, body: faultEnvelope},
+		{name: "BadHTTPStatus", statusCode: http.StatusServiceUnavailable, body: "Service Unavailable", err: customsoap.ErrBadHTTPStatus},
+		{name: "RedirectStatus", statusCode: http.StatusNotModified, body: "", err: customsoap.ErrBadHTTPStatus},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run("TestSoapCall: "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, `"http://web.cbr.ru/KeyRateXML"`, r.Header.Get("SOAPAction"))
+				w.WriteHeader(testCase.statusCode)
+				_, _ = w.Write([]byte(testCase.body))
+			}))
+			defer server.Close()
+
+			logger, err := mocks.NewLoggerMock(false)
+			require.NoError(t, err)
+			sender := customsoap.New(logger, &testConfig{address: server.URL})
+			input := datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+			input.Init()
+			body, err := sender.SoapCall(context.Background(), "KeyRateXML", input)
+
+			var fault *customsoap.SOAPFault
+			switch {
+			case testCase.body == faultEnvelope:
+				require.True(t, errors.As(err, &fault))
+				require.Equal(t, testCase.statusCode, fault.HTTPStatus)
+				require.Equal(t, "soap:Server", fault.Code)
+				require.Nil(t, body)
+			case testCase.err != nil:
+				require.ErrorIs(t, err, testCase.err)
+				require.Nil(t, body)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, testCase.body, string(body))
+			}
+		})
+	}
+}
+
+type sequenceSenderMock struct {
+	errs  []error
+	calls int
+}
+
+func (ssm *sequenceSenderMock) SoapCall(_ context.Context, _ string, _ interface{}) ([]byte, error) {
+	ssm.calls++
+	if ssm.calls <= len(ssm.errs) {
+		return nil, ssm.errs[ssm.calls-1]
+	}
+	return []byte(keyRateEnvelope), nil
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryPolicyValidate(t *testing.T) {
+	t.Parallel()
+	policy := customsoap.DefaultRetryPolicy()
+	require.NoError(t, policy.Validate())
+	badPolicies := []customsoap.RetryPolicy{
+		{MaxAttempts: 0, BaseDelay: time.Millisecond, MaxDelay: time.Second},
+		{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Millisecond},
+		{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second, RetryOn: []string{"http4xx"}},
+	}
+	for _, policy := range badPolicies {
+		policy := policy
+		require.ErrorIs(t, policy.Validate(), customsoap.ErrBadRetryPolicy)
+		_, err := customsoap.NewRetrySender(nil, &sequenceSenderMock{}, policy, customsoap.RetryMetrics{})
+		require.ErrorIs(t, err, customsoap.ErrBadRetryPolicy)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, customsoap.RetryOn5xx, customsoap.ErrorClass(&customsoap.HTTPStatusError{StatusCode: http.StatusBadGateway}))
+	require.Equal(t, "", customsoap.ErrorClass(&customsoap.HTTPStatusError{StatusCode: http.StatusNotFound}))
+	require.Equal(t, customsoap.RetryOnFault, customsoap.ErrorClass(&customsoap.SOAPFault{Code: "soap:Server"}))
+	require.Equal(t, customsoap.RetryOnTimeout, customsoap.ErrorClass(&url.Error{Op: "Post", Err: timeoutError{}}))
+	require.Equal(t, customsoap.RetryOnNetwork, customsoap.ErrorClass(&url.Error{Op: "Post", Err: syscall.ECONNREFUSED}))
+	require.Equal(t, customsoap.RetryOnNetwork, customsoap.ErrorClass(io.ErrUnexpectedEOF))
+	require.Equal(t, "", customsoap.ErrorClass(customsoap.ErrBadLenEnvelopeSlice))
+}
+
+func TestRetrySender(t *testing.T) {
+	t.Parallel()
+	errNetwork := &url.Error{Op: "Post", Err: syscall.ECONNRESET}
+	err5xx := &customsoap.HTTPStatusError{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}
+	err4xx := &customsoap.HTTPStatusError{StatusCode: http.StatusBadRequest, Status: "400 Bad Request"}
+	fault := &customsoap.SOAPFault{Code: "soap:Server"}
+	defaultRetryOn := customsoap.DefaultRetryPolicy().RetryOn
+	testCases := []struct {
+		name    string
+		errs    []error
+		retryOn []string
+		timeout time.Duration
+		calls   int
+		err     error
+	}{
+		{name: "Success", errs: nil, retryOn: defaultRetryOn, calls: 1},
+		{name: "RetryNetwork", errs: []error{errNetwork, errNetwork}, retryOn: defaultRetryOn, calls: 3},
+		{name: "Retry5xx", errs: []error{err5xx}, retryOn: defaultRetryOn, calls: 2},
+		{name: "MaxAttempts", errs: []error{err5xx, err5xx, err5xx, err5xx}, retryOn: defaultRetryOn, calls: 3, err: err5xx},
+		{name: "No4xxRetry", errs: []error{err4xx}, retryOn: defaultRetryOn, calls: 1, err: err4xx},
+		{name: "NoFaultRetry", errs: []error{fault}, retryOn: defaultRetryOn, calls: 1, err: fault},
+		{name: "FaultRetry", errs: []error{fault}, retryOn: []string{customsoap.RetryOnFault}, calls: 2},
+		{name: "NotConfiguredClass", errs: []error{errNetwork}, retryOn: []string{customsoap.RetryOn5xx}, calls: 1, err: errNetwork},
+		{name: "DeadlineBeforeDelay", errs: []error{err5xx}, retryOn: defaultRetryOn, timeout: 5 * time.Millisecond, calls: 1, err: err5xx},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run("TestRetrySender: "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+			logger, err := mocks.NewLoggerMock(false)
+			require.NoError(t, err)
+			next := &sequenceSenderMock{errs: testCase.errs}
+			policy := customsoap.RetryPolicy{MaxAttempts: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: 20 * time.Millisecond, RetryOn: testCase.retryOn}
+			sender, err := customsoap.NewRetrySender(logger, next, policy, customsoap.RetryMetrics{})
+			require.NoError(t, err)
+			ctx := context.Background()
+			if testCase.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, testCase.timeout)
+				defer cancel()
+			}
+			body, err := sender.SoapCall(ctx, "KeyRateXML", nil)
+			require.Equal(t, testCase.calls, next.calls)
+			if testCase.err != nil {
+				require.ErrorIs(t, err, testCase.err)
+				require.Nil(t, body)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, keyRateEnvelope, string(body))
+		})
+	}
+}
+
+func TestCircuitBreakerConfigValidate(t *testing.T) {
+	t.Parallel()
+	badConfigs := []customsoap.CircuitBreakerConfig{
+		{FailureThreshold: 0, OpenTimeout: time.Second, HalfOpenMaxCalls: 1},
+		{FailureThreshold: 1, OpenTimeout: 0, HalfOpenMaxCalls: 1},
+		{FailureThreshold: 1, OpenTimeout: time.Second, HalfOpenMaxCalls: 0},
+	}
+	for _, config := range badConfigs {
+		_, err := customsoap.NewCircuitBreaker(nil, &sequenceSenderMock{}, config, customsoap.CircuitBreakerMetrics{})
+		require.ErrorIs(t, err, customsoap.ErrBadCircuitBreakerCfg)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	logger, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	err5xx := &customsoap.HTTPStatusError{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}
+	fault := &customsoap.SOAPFault{Code: "soap:Client"}
+	next := &sequenceSenderMock{errs: []error{err5xx, fault, err5xx, err5xx, err5xx, err5xx}}
+	config := customsoap.CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: 20 * time.Millisecond, HalfOpenMaxCalls: 1}
+	breaker, err := customsoap.NewCircuitBreaker(logger, next, config, customsoap.CircuitBreakerMetrics{})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// SOAP fault is an answer of CBR WS, so it resets consecutive failures.
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorAs(t, err, &fault)
+	require.Equal(t, customsoap.StateClosed, breaker.Status().State)
+	require.Equal(t, 0, breaker.Status().ConsecutiveFailures)
+
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+	status := breaker.Status()
+	require.Equal(t, customsoap.StateOpen, status.State)
+	require.NotNil(t, status.OpenedAt)
+	require.NotNil(t, status.RetryAt)
+	require.Equal(t, err5xx.Error(), status.LastError)
+
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, customsoap.ErrCircuitOpen)
+	require.Equal(t, 4, next.calls)
+
+	// probe fails: breaker is open again.
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, customsoap.StateHalfOpen, breaker.Status().State)
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+	require.Equal(t, customsoap.StateOpen, breaker.Status().State)
+	require.Equal(t, 5, next.calls)
+
+	// canceled probe does not change state.
+	time.Sleep(30 * time.Millisecond)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	canceledNext := &sequenceSenderMock{errs: []error{context.Canceled}}
+	canceledBreaker, err := customsoap.NewCircuitBreaker(logger, canceledNext, config, customsoap.CircuitBreakerMetrics{})
+	require.NoError(t, err)
+	_, err = canceledBreaker.SoapCall(canceledCtx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 0, canceledBreaker.Status().ConsecutiveFailures)
+
+	// probe fails once more, then succeeds: breaker is closed.
+	_, err = breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.ErrorIs(t, err, customsoap.ErrBadHTTPStatus)
+	time.Sleep(30 * time.Millisecond)
+	body, err := breaker.SoapCall(ctx, "KeyRateXML", nil)
+	require.NoError(t, err)
+	require.Equal(t, keyRateEnvelope, string(body))
+	status = breaker.Status()
+	require.Equal(t, customsoap.StateClosed, status.State)
+	require.Nil(t, status.OpenedAt)
+	require.Equal(t, 0, status.ConsecutiveFailures)
+}
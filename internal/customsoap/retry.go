@@ -0,0 +1,200 @@
+package customsoap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Classes of errors, which can be retried.
+const (
+	RetryOnNetwork = "network"
+	RetryOnTimeout = "timeout"
+	RetryOn5xx     = "http5xx"
+	RetryOnFault   = "fault"
+)
+
+var ErrBadRetryPolicy = errors.New("bad retry policy")
+
+type SoapRequestSender interface {
+	SoapCall(ctx context.Context, action string, payload interface{}) ([]byte, error)
+}
+
+// RetryPolicy: delay before attempt n+1 is random in [d/2, d], where d = min(BaseDelay*2^(n-1), MaxDelay).
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	RetryOn     []string
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    time.Second,
+		RetryOn:     []string{RetryOnNetwork, RetryOnTimeout, RetryOn5xx},
+	}
+}
+
+func (rp *RetryPolicy) Validate() error {
+	if rp.MaxAttempts < 1 {
+		return fmt.Errorf("%w: max attempts %d < 1", ErrBadRetryPolicy, rp.MaxAttempts)
+	}
+	if rp.BaseDelay < 0 || rp.MaxDelay < rp.BaseDelay {
+		return fmt.Errorf("%w: base delay %s, max delay %s", ErrBadRetryPolicy, rp.BaseDelay, rp.MaxDelay)
+	}
+	for _, class := range rp.RetryOn {
+		switch class {
+		case RetryOnNetwork, RetryOnTimeout, RetryOn5xx, RetryOnFault:
+		default:
+			return fmt.Errorf("%w: unknown error class %q", ErrBadRetryPolicy, class)
+		}
+	}
+	return nil
+}
+
+func (rp *RetryPolicy) delay(attempt int, randInt63n func(int64) int64) time.Duration {
+	d := rp.BaseDelay
+	for i := 1; i < attempt && d < rp.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > rp.MaxDelay {
+		d = rp.MaxDelay
+	}
+	if d <= 1 {
+		return d
+	}
+	return d/2 + time.Duration(randInt63n(int64(d/2)+1))
+}
+
+// ErrorClass returns class of the SoapCall error, void class is not retried.
+func ErrorClass(err error) string {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		if statusErr.StatusCode >= http.StatusInternalServerError {
+			return RetryOn5xx
+		}
+		return ""
+	}
+	var fault *SOAPFault
+	if errors.As(err, &fault) {
+		return RetryOnFault
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return RetryOnTimeout
+		}
+		return RetryOnNetwork
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		return RetryOnNetwork
+	}
+	return ""
+}
+
+type RetryMetrics struct {
+	Attempts *prometheus.CounterVec
+}
+
+func CreateRetryMetrics() RetryMetrics {
+	return RetryMetrics{
+		Attempts: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "soap",
+			Name:      "call_attempts_total",
+		}, []string{"action", "attempt", "result"}),
+	}
+}
+
+// RetrySender repeats failed calls of the wrapped sender by the retry policy within the request context deadline.
+type RetrySender struct {
+	logger  Logger
+	next    SoapRequestSender
+	policy  RetryPolicy
+	retryOn map[string]struct{}
+	metrics RetryMetrics
+	muRand  sync.Mutex
+	rand    *rand.Rand
+}
+
+func NewRetrySender(logger Logger, next SoapRequestSender, policy RetryPolicy, metrics RetryMetrics) (*RetrySender, error) {
+	err := policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+	retryOn := make(map[string]struct{}, len(policy.RetryOn))
+	for _, class := range policy.RetryOn {
+		retryOn[class] = struct{}{}
+	}
+	return &RetrySender{
+		logger:  logger,
+		next:    next,
+		policy:  policy,
+		retryOn: retryOn,
+		metrics: metrics,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}, nil
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (rs *RetrySender) randInt63n(n int64) int64 {
+	rs.muRand.Lock()
+	defer rs.muRand.Unlock()
+	return rs.rand.Int63n(n)
+}
+
+func (rs *RetrySender) SoapCall(ctx context.Context, action string, payload interface{}) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := rs.next.SoapCall(ctx, action, payload)
+		if err == nil {
+			rs.observeAttempt(action, attempt, "success")
+			return res, nil
+		}
+		_, retryable := rs.retryOn[ErrorClass(err)]
+		if !retryable || ctx.Err() != nil || attempt >= rs.policy.MaxAttempts {
+			rs.observeAttempt(action, attempt, "error")
+			return nil, err
+		}
+		delay := rs.policy.delay(attempt, rs.randInt63n)
+		deadline, ok := ctx.Deadline()
+		if ok && time.Until(deadline) <= delay {
+			rs.observeAttempt(action, attempt, "error")
+			return nil, err
+		}
+		rs.observeAttempt(action, attempt, "retry")
+		rs.logger.Warning(action + ": attempt " + strconv.Itoa(attempt) + " failed, retry in " + delay.String() + ": " + err.Error())
+		if sleepCtx(ctx, delay) != nil {
+			return nil, err
+		}
+	}
+}
+
+func (rs *RetrySender) observeAttempt(action string, attempt int, result string) {
+	if rs.metrics.Attempts == nil {
+		return
+	}
+	rs.metrics.Attempts.With(prometheus.Labels{"action": action, "attempt": strconv.Itoa(attempt), "result": result}).Add(1)
+}
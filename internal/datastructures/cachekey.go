@@ -0,0 +1,70 @@
+package datastructures
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// NormalizeRequest trims string params and reformats dates by the input layout, so equal requests have equal cache keys
+// and the same request is sent to CBR WS. Request must be a pointer to the request structure.
+func NormalizeRequest(request RequestData) {
+	value := reflect.ValueOf(request)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() != reflect.String || !field.CanSet() || value.Type().Field(i).Tag.Get("json") == "-" {
+			continue
+		}
+		param := strings.TrimSpace(field.String())
+		date, err := time.Parse(inputDTLayout, param)
+		if err == nil {
+			param = date.Format(inputDTLayout)
+		}
+		field.SetString(param)
+	}
+}
+
+// CacheKey is the method name with JSON of the typed request: params are in the order of the request structure,
+// so the key does not depend on formatting, order and case of the keys of the client JSON.
+// Key of the method without params is its name.
+func CacheKey(methodName string, request interface{}) (string, error) {
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	if string(jsonRequest) == "{}" {
+		return methodName, nil
+	}
+	return methodName + string(jsonRequest), nil
+}
+
+// RequestEndDate returns the latest of the date params of the request, false if the request has no date params.
+func RequestEndDate(request RequestData) (time.Time, bool) {
+	var endDate time.Time
+	found := false
+	value := reflect.ValueOf(request)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return endDate, false
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() != reflect.String || value.Type().Field(i).Tag.Get("json") == "-" {
+			continue
+		}
+		date, err := time.Parse(inputDTLayout, strings.TrimSpace(field.String()))
+		if err != nil {
+			continue
+		}
+		if !found || date.After(endDate) {
+			endDate = date
+			found = true
+		}
+	}
+	return endDate, found
+}
@@ -1,3 +1,5 @@
+//go:generate go run ../../cmd/wsdlgen
+
 package datastructures
 
 import (
@@ -12,4 +14,5 @@ const (
 var (
 	ErrBadInputDateData = errors.New("fromDate after toDate")
 	ErrBadRawData       = errors.New("parse raw date error")
+	ErrBadValutaCode    = errors.New("void valuta code")
 )
@@ -1,6 +1,7 @@
 package datastructures_test
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"testing"
 	"time"
@@ -45,7 +46,7 @@ func (dtc *DatastructuresTestCase) ValidateControlTest(t *testing.T) {
 
 func initAllDatastructuresTestTable(t *testing.T) AllDatastructuresTestTable {
 	t.Helper()
-	AllDTTable := make(AllDatastructuresTestTable, 32)
+	AllDTTable := make(AllDatastructuresTestTable, 40)
 	AllDTTable[0] = initTestCasesGetCursOnDateXML(t)
 	AllDTTable[1] = initTestCasesBiCurBaseXML(t)
 	AllDTTable[2] = initTestCasesBliquidityXML(t)
@@ -78,6 +79,14 @@ func initAllDatastructuresTestTable(t *testing.T) AllDatastructuresTestTable {
 	AllDTTable[29] = initTestCasesSwapInfoSellXML(t)
 	AllDTTable[30] = initTestCasesSwapMonthTotalXML(t)
 	AllDTTable[31] = initTestCasesAllDataInfoXML(t)
+	AllDTTable[32] = initTestCasesGetCursDynamicXML(t)
+	AllDTTable[33] = initTestCasesCoinsBaseXML(t)
+	AllDTTable[34] = initTestCasesFixingBaseXML(t)
+	AllDTTable[35] = initTestCasesGetReutersCursOnDateXML(t)
+	AllDTTable[36] = initTestCasesGetLatestDateTime(t)
+	AllDTTable[37] = initTestCasesMKRXML(t)
+	AllDTTable[38] = initTestCasesGetSeldCursDynamicXML(t)
+	AllDTTable[39] = initTestCasesGetSeldCursOnDateXML(t)
 	return AllDTTable
 }
 
@@ -4495,6 +4504,1068 @@ func initTestCasesAllDataInfoXML(t *testing.T) DatastructuresTestTable { // noli
 	return DatastructuresTest
 }
 
+func initTestCasesGetCursDynamicXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "GetCursDynamicXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 5)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "GetCursDynamicXML",
+		Datastructure: datastructures.GetCursDynamicXML{
+			FromDate:   "2023-06-22",
+			ToDate:     "2023-06-23",
+			ValutaCode: "R01235",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetCursDynamicXML xmlns="http://web.cbr.ru/"><FromDate>2023-06-22</FromDate><ToDate>2023-06-23</ToDate><ValutaCode>R01235</ValutaCode></GetCursDynamicXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetCursDynamicXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeBadRawData",
+		DataStructureType: "GetCursDynamicXML",
+		Datastructure: datastructures.GetCursDynamicXML{
+			FromDate:   "022-14-22",
+			ToDate:     "2023-06-23",
+			ValutaCode: "R01235",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeFromDateAfterToDate",
+		DataStructureType: "GetCursDynamicXML",
+		Datastructure: datastructures.GetCursDynamicXML{
+			FromDate:   "2023-06-23",
+			ToDate:     "2023-06-22",
+			ValutaCode: "R01235",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadInputDateData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeVoidValutaCode",
+		DataStructureType: "GetCursDynamicXML",
+		Datastructure: datastructures.GetCursDynamicXML{
+			FromDate:   "2023-06-22",
+			ToDate:     "2023-06-23",
+			ValutaCode: "",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadValutaCode,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[3] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "GetCursDynamicXML",
+		Datastructure: datastructures.GetCursDynamicXML{
+			FromDate:   "2023-06-22",
+			ToDate:     "2023-06-23",
+			ValutaCode: "R01235",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[4] = newCase
+	testGetCursDynamicXMLResult := datastructures.GetCursDynamicXMLResult{
+		ValuteCursDynamic: make([]datastructures.GetCursDynamicXMLResultElem, 2),
+	}
+	testGetCursDynamicXMLResultElem := datastructures.GetCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01235",
+		Vnom:      1,
+		Vcurs:     "84.2467",
+		VunitRate: "84.2467",
+	}
+	testGetCursDynamicXMLResult.ValuteCursDynamic[0] = testGetCursDynamicXMLResultElem
+	testGetCursDynamicXMLResultElem = datastructures.GetCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01235",
+		Vnom:      1,
+		Vcurs:     "83.9499",
+		VunitRate: "83.9499",
+	}
+	testGetCursDynamicXMLResult.ValuteCursDynamic[1] = testGetCursDynamicXMLResultElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "GetCursDynamicXML",
+		Datastructure:     testGetCursDynamicXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetCursDynamicXMLResult><ValuteCursDynamic><CursDate>2023-06-22T00:00:00Z</CursDate><Vcode>R01235</Vcode><Vnom>1</Vnom><Vcurs>84.2467</Vcurs><VunitRate>84.2467</VunitRate></ValuteCursDynamic><ValuteCursDynamic><CursDate>2023-06-23T00:00:00Z</CursDate><Vcode>R01235</Vcode><Vnom>1</Vnom><Vcurs>83.9499</Vcurs><VunitRate>83.9499</VunitRate></ValuteCursDynamic></GetCursDynamicXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetCursDynamicXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetCursDynamicXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesCoinsBaseXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "CoinsBaseXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 4)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "Coins_baseXML",
+		Datastructure: datastructures.Coins_baseXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<Coins_baseXML xmlns="http://web.cbr.ru/"><fromDate>2023-06-22</fromDate><ToDate>2023-06-23</ToDate></Coins_baseXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.Coins_baseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:Coins_baseXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeBadRawData",
+		DataStructureType: "Coins_baseXML",
+		Datastructure: datastructures.Coins_baseXML{
+			FromDate: "022-14-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.Coins_baseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:Coins_baseXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeFromDateAfterToDate",
+		DataStructureType: "Coins_baseXML",
+		Datastructure: datastructures.Coins_baseXML{
+			FromDate: "2023-06-23",
+			ToDate:   "2023-06-22",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadInputDateData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.Coins_baseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:Coins_baseXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "Coins_baseXML",
+		Datastructure: datastructures.Coins_baseXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.Coins_baseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:Coins_baseXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[3] = newCase
+	testCoins_baseXMLResult := datastructures.Coins_baseXMLResult{ //nolint:revive, stylecheck, nolintlint
+		CB: make([]datastructures.Coins_baseXMLResultElem, 2),
+	}
+	testCoins_baseXMLElem := datastructures.Coins_baseXMLResultElem{ //nolint:revive, stylecheck, nolintlint
+		Date:       time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		Cat_number: "5111-0178",
+		Price:      "48000.00",
+	}
+	testCoins_baseXMLResult.CB[0] = testCoins_baseXMLElem
+	testCoins_baseXMLElem = datastructures.Coins_baseXMLResultElem{
+		Date:       time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		Cat_number: "5111-0178",
+		Price:      "47500.00",
+	}
+	testCoins_baseXMLResult.CB[1] = testCoins_baseXMLElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "Coins_baseXML",
+		Datastructure:     testCoins_baseXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<Coins_baseXMLResult><CB><date>2023-06-22T00:00:00Z</date><Cat_number>5111-0178</Cat_number><price>48000.00</price></CB><CB><date>2023-06-23T00:00:00Z</date><Cat_number>5111-0178</Cat_number><price>47500.00</price></CB></Coins_baseXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.Coins_baseXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:Coins_baseXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesFixingBaseXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "FixingBaseXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 4)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "FixingBaseXML",
+		Datastructure: datastructures.FixingBaseXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<FixingBaseXML xmlns="http://web.cbr.ru/"><fromDate>2023-06-22</fromDate><ToDate>2023-06-23</ToDate></FixingBaseXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.FixingBaseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:FixingBaseXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeBadRawData",
+		DataStructureType: "FixingBaseXML",
+		Datastructure: datastructures.FixingBaseXML{
+			FromDate: "022-14-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.FixingBaseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:FixingBaseXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeFromDateAfterToDate",
+		DataStructureType: "FixingBaseXML",
+		Datastructure: datastructures.FixingBaseXML{
+			FromDate: "2023-06-23",
+			ToDate:   "2023-06-22",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadInputDateData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.FixingBaseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:FixingBaseXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "FixingBaseXML",
+		Datastructure: datastructures.FixingBaseXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.FixingBaseXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:FixingBaseXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[3] = newCase
+	testFixingBaseXMLResult := datastructures.FixingBaseXMLResult{
+		FB: make([]datastructures.FixingBaseXMLResultElem, 2),
+	}
+	testFixingBaseXMLResultElem := datastructures.FixingBaseXMLResultElem{
+		D0:     time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		CodMet: "1",
+		Price:  "5231.5400",
+	}
+	testFixingBaseXMLResult.FB[0] = testFixingBaseXMLResultElem
+	testFixingBaseXMLResultElem = datastructures.FixingBaseXMLResultElem{
+		D0:     time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		CodMet: "1",
+		Price:  "5180.1100",
+	}
+	testFixingBaseXMLResult.FB[1] = testFixingBaseXMLResultElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "FixingBaseXML",
+		Datastructure:     testFixingBaseXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<FixingBaseXMLResult><FB><D0>2023-06-22T00:00:00Z</D0><CodMet>1</CodMet><price>5231.5400</price></FB><FB><D0>2023-06-23T00:00:00Z</D0><CodMet>1</CodMet><price>5180.1100</price></FB></FixingBaseXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.FixingBaseXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:FixingBaseXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesGetReutersCursOnDateXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "GetReutersCursOnDateXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 3)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "GetReutersCursOnDateXML",
+		Datastructure: datastructures.GetReutersCursOnDateXML{
+			OnDate: "2023-06-22",
+			XMLNs:  "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetReutersCursOnDateXML xmlns="http://web.cbr.ru/"><On_date>2023-06-22</On_date></GetReutersCursOnDateXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetReutersCursOnDateXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetReutersCursOnDateXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegative",
+		DataStructureType: "GetReutersCursOnDateXML",
+		Datastructure: datastructures.GetReutersCursOnDateXML{
+			OnDate: "022-14-22",
+			XMLNs:  "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetReutersCursOnDateXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetReutersCursOnDateXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "GetReutersCursOnDateXML",
+		Datastructure: datastructures.GetReutersCursOnDateXML{
+			OnDate: "2023-06-22",
+			XMLNs:  "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetReutersCursOnDateXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetReutersCursOnDateXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	testGetReutersCursOnDateXMLResult := datastructures.GetReutersCursOnDateXMLResult{
+		OnDate:   "20230622",
+		Currency: make([]datastructures.GetReutersCursOnDateXMLResultElem, 2),
+	}
+	testGetReutersCursOnDateXMLResultElem := datastructures.GetReutersCursOnDateXMLResultElem{
+		Num_code: 8,
+		Val:      "0.8454",
+		Dir:      1,
+	}
+	testGetReutersCursOnDateXMLResult.Currency[0] = testGetReutersCursOnDateXMLResultElem
+	testGetReutersCursOnDateXMLResultElem = datastructures.GetReutersCursOnDateXMLResultElem{
+		Num_code: 12,
+		Val:      "0.6222",
+		Dir:      1,
+	}
+	testGetReutersCursOnDateXMLResult.Currency[1] = testGetReutersCursOnDateXMLResultElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "GetReutersCursOnDateXML",
+		Datastructure:     testGetReutersCursOnDateXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetReutersCursOnDateXMLResult OnDate="20230622"><Currency><num_code>8</num_code><val>0.8454</val><dir>1</dir></Currency><Currency><num_code>12</num_code><val>0.6222</val><dir>1</dir></Currency></GetReutersCursOnDateXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetReutersCursOnDateXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetReutersCursOnDateXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesGetLatestDateTime(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "GetLatestDateTime"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 3)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlInGetLatestDateTime",
+		DataStructureType: "GetLatestDateTime",
+		Datastructure: datastructures.GetLatestDateTime{
+			XMLNs: "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetLatestDateTime xmlns="http://web.cbr.ru/"></GetLatestDateTime>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetLatestDateTime)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetLatestDateTime")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlInGetLatestDateTimeSeld",
+		DataStructureType: "GetLatestDateTimeSeld",
+		Datastructure: datastructures.GetLatestDateTimeSeld{
+			XMLNs: "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetLatestDateTimeSeld xmlns="http://web.cbr.ru/"></GetLatestDateTimeSeld>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetLatestDateTimeSeld)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetLatestDateTimeSeld")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlInGetLatestReutersDateTime",
+		DataStructureType: "GetLatestReutersDateTime",
+		Datastructure: datastructures.GetLatestReutersDateTime{
+			XMLNs: "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetLatestReutersDateTime xmlns="http://web.cbr.ru/"></GetLatestReutersDateTime>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetLatestReutersDateTime)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetLatestReutersDateTime")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[2] = newCase
+	testLatestDateTimeResult := datastructures.LatestDateTimeResult{
+		LatestDateTime: "2023-06-23T00:00:00",
+	}
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "LatestDateTimeResult",
+		Datastructure:     testLatestDateTimeResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<LatestDateTimeResult>2023-06-23T00:00:00</LatestDateTimeResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.LatestDateTimeResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:LatestDateTimeResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesMKRXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "MKRXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 4)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "MKRXML",
+		Datastructure: datastructures.MKRXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<MKRXML xmlns="http://web.cbr.ru/"><fromDate>2023-06-22</fromDate><ToDate>2023-06-23</ToDate></MKRXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.MKRXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:MKRXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeBadRawData",
+		DataStructureType: "MKRXML",
+		Datastructure: datastructures.MKRXML{
+			FromDate: "022-14-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.MKRXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:MKRXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeFromDateAfterToDate",
+		DataStructureType: "MKRXML",
+		Datastructure: datastructures.MKRXML{
+			FromDate: "2023-06-23",
+			ToDate:   "2023-06-22",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadInputDateData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.MKRXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:MKRXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "MKRXML",
+		Datastructure: datastructures.MKRXML{
+			FromDate: "2023-06-22",
+			ToDate:   "2023-06-23",
+			XMLNs:    "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.MKRXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:MKRXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[3] = newCase
+	testMKRXMLResult := datastructures.MKRXMLResult{
+		MKR: make([]datastructures.MKRXMLResultElem, 2),
+	}
+	testMKRXMLResultElem := datastructures.MKRXMLResultElem{
+		CDate: time.Date(2023, time.June, 22, 0, 0, 0, 0, time.UTC),
+		P1:    "1",
+		D1:    "7.25",
+		D7:    "7.40",
+		D30:   "7.66",
+		D90:   "7.93",
+		D180:  "8.17",
+		D360:  "8.53",
+	}
+	testMKRXMLResult.MKR[0] = testMKRXMLResultElem
+	testMKRXMLResultElem = datastructures.MKRXMLResultElem{
+		CDate: time.Date(2023, time.June, 23, 0, 0, 0, 0, time.UTC),
+		P1:    "1",
+		D1:    "7.27",
+		D7:    "7.41",
+		D30:   "7.67",
+		D90:   "7.95",
+		D180:  "8.20",
+		D360:  "8.56",
+	}
+	testMKRXMLResult.MKR[1] = testMKRXMLResultElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "MKRXML",
+		Datastructure:     testMKRXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<MKRXMLResult><MKR><CDate>2023-06-22T00:00:00Z</CDate><p1>1</p1><d1>7.25</d1><d7>7.40</d7><d30>7.66</d30><d90>7.93</d90><d180>8.17</d180><d360>8.53</d360></MKR><MKR><CDate>2023-06-23T00:00:00Z</CDate><p1>1</p1><d1>7.27</d1><d7>7.41</d7><d30>7.67</d30><d90>7.95</d90><d180>8.20</d180><d360>8.56</d360></MKR></MKRXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.MKRXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:MKRXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesGetSeldCursDynamicXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "GetSeldCursDynamicXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 5)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "GetSeldCursDynamicXML",
+		Datastructure: datastructures.GetSeldCursDynamicXML{
+			FromDate:   "2023-05-01",
+			ToDate:     "2023-06-01",
+			ValutaCode: "R01030",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetSeldCursDynamicXML xmlns="http://web.cbr.ru/"><FromDate>2023-05-01</FromDate><ToDate>2023-06-01</ToDate><ValutaCode>R01030</ValutaCode></GetSeldCursDynamicXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursDynamicXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeBadRawData",
+		DataStructureType: "GetSeldCursDynamicXML",
+		Datastructure: datastructures.GetSeldCursDynamicXML{
+			FromDate:   "022-14-22",
+			ToDate:     "2023-06-01",
+			ValutaCode: "R01030",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeFromDateAfterToDate",
+		DataStructureType: "GetSeldCursDynamicXML",
+		Datastructure: datastructures.GetSeldCursDynamicXML{
+			FromDate:   "2023-06-01",
+			ToDate:     "2023-05-01",
+			ValutaCode: "R01030",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadInputDateData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegativeVoidValutaCode",
+		DataStructureType: "GetSeldCursDynamicXML",
+		Datastructure: datastructures.GetSeldCursDynamicXML{
+			FromDate:   "2023-05-01",
+			ToDate:     "2023-06-01",
+			ValutaCode: "",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadValutaCode,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[3] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "GetSeldCursDynamicXML",
+		Datastructure: datastructures.GetSeldCursDynamicXML{
+			FromDate:   "2023-05-01",
+			ToDate:     "2023-06-01",
+			ValutaCode: "R01030",
+			XMLNs:      "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursDynamicXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursDynamicXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[4] = newCase
+	testGetSeldCursDynamicXMLResult := datastructures.GetSeldCursDynamicXMLResult{
+		ValuteCursDynamic: make([]datastructures.GetSeldCursDynamicXMLResultElem, 2),
+	}
+	testGetSeldCursDynamicXMLResultElem := datastructures.GetSeldCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01030",
+		Vnom:      100,
+		Vcurs:     "91.2345",
+		VunitRate: "0.912345",
+	}
+	testGetSeldCursDynamicXMLResult.ValuteCursDynamic[0] = testGetSeldCursDynamicXMLResultElem
+	testGetSeldCursDynamicXMLResultElem = datastructures.GetSeldCursDynamicXMLResultElem{
+		CursDate:  time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Vcode:     "R01030",
+		Vnom:      100,
+		Vcurs:     "92.3456",
+		VunitRate: "0.923456",
+	}
+	testGetSeldCursDynamicXMLResult.ValuteCursDynamic[1] = testGetSeldCursDynamicXMLResultElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "GetSeldCursDynamicXML",
+		Datastructure:     testGetSeldCursDynamicXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetSeldCursDynamicXMLResult><ValuteCursDynamic><CursDate>2023-05-01T00:00:00Z</CursDate><Vcode>R01030</Vcode><Vnom>100</Vnom><Vcurs>91.2345</Vcurs><VunitRate>0.912345</VunitRate></ValuteCursDynamic><ValuteCursDynamic><CursDate>2023-06-01T00:00:00Z</CursDate><Vcode>R01030</Vcode><Vnom>100</Vnom><Vcurs>92.3456</Vcurs><VunitRate>0.923456</VunitRate></ValuteCursDynamic></GetSeldCursDynamicXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursDynamicXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursDynamicXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
+func initTestCasesGetSeldCursOnDateXML(t *testing.T) DatastructuresTestTable { // nolint:funlen, nolintlint
+	t.Helper()
+	DatastructuresTest := DatastructuresTestTable{}
+	DatastructuresTest.MethodName = "GetSeldCursOnDateXML"
+	DatastructuresTest.InputDataCases = make([]DatastructuresTestCase, 3)
+	DatastructuresTest.OutputDataCases = make([]DatastructuresTestCase, 1)
+	var newCase DatastructuresTestCase
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlIn",
+		DataStructureType: "GetSeldCursOnDateXML",
+		Datastructure: datastructures.GetSeldCursOnDateXML{
+			OnDate: "2023-06-01",
+			XMLNs:  "http://web.cbr.ru/",
+		},
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetSeldCursOnDateXML xmlns="http://web.cbr.ru/"><On_date>2023-06-01</On_date></GetSeldCursOnDateXML>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursOnDateXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursOnDateXML")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.InputDataCases[0] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlNegative",
+		DataStructureType: "GetSeldCursOnDateXML",
+		Datastructure: datastructures.GetSeldCursOnDateXML{
+			OnDate: "022-14-22",
+			XMLNs:  "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: datastructures.ErrBadRawData,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursOnDateXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursOnDateXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[1] = newCase
+	newCase = DatastructuresTestCase{
+		Name:              "ValidateControlPositive",
+		DataStructureType: "GetSeldCursOnDateXML",
+		Datastructure: datastructures.GetSeldCursOnDateXML{
+			OnDate: "2023-06-01",
+			XMLNs:  "http://web.cbr.ru/",
+		},
+		NeedValidate:    true,
+		ValidateControl: nil,
+	}
+	newCase.MarshalXMLTestFunc = func(_ *testing.T, _ interface{}, _ string) {}
+	newCase.ValidateControlTestFunc = func(t *testing.T, Datastructure interface{}, ValidateControl error) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursOnDateXML)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursOnDateXML")
+		}
+		err := DSAssert.Validate()
+		require.Equal(t, ValidateControl, err)
+	}
+	DatastructuresTest.InputDataCases[2] = newCase
+	testGetSeldCursOnDateXMLResult := datastructures.GetSeldCursOnDateXMLResult{
+		OnDate:           "20230601",
+		ValuteCursOnDate: make([]datastructures.GetSeldCursOnDateXMLResultElem, 2),
+	}
+	testGetSeldCursOnDateXMLResultElem := datastructures.GetSeldCursOnDateXMLResultElem{
+		Vname:   "Афганский афгани",
+		Vnom:    100,
+		Vcurs:   "92.3456",
+		Vcode:   "971",
+		VchCode: "AFN",
+	}
+	testGetSeldCursOnDateXMLResult.ValuteCursOnDate[0] = testGetSeldCursOnDateXMLResultElem
+	testGetSeldCursOnDateXMLResultElem = datastructures.GetSeldCursOnDateXMLResultElem{
+		Vname:   "Алжирский динар",
+		Vnom:    100,
+		Vcurs:   "59.1234",
+		Vcode:   "12",
+		VchCode: "DZD",
+	}
+	testGetSeldCursOnDateXMLResult.ValuteCursOnDate[1] = testGetSeldCursOnDateXMLResultElem
+
+	newCase = DatastructuresTestCase{
+		Name:              "XMLMarshalControlOut",
+		DataStructureType: "GetSeldCursOnDateXML",
+		Datastructure:     testGetSeldCursOnDateXMLResult,
+		NeedXMLMarshal:    true,
+		XMLMarshalControl: `<GetSeldCursOnDateXMLResult OnDate="20230601"><ValuteCursOnDate><Vname>Афганский афгани</Vname><Vnom>100</Vnom><Vcurs>92.3456</Vcurs><Vcode>971</Vcode><VchCode>AFN</VchCode></ValuteCursOnDate><ValuteCursOnDate><Vname>Алжирский динар</Vname><Vnom>100</Vnom><Vcurs>59.1234</Vcurs><Vcode>12</Vcode><VchCode>DZD</VchCode></ValuteCursOnDate></GetSeldCursOnDateXMLResult>`,
+	}
+	newCase.MarshalXMLTestFunc = func(t *testing.T, Datastructure interface{}, XMLMarshalControl string) {
+		t.Helper()
+		DSAssert, ok := Datastructure.(datastructures.GetSeldCursOnDateXMLResult)
+		if !ok {
+			require.Fail(t, "fail type assertion in MarshalXMLTestFunc:GetSeldCursOnDateXMLResult")
+		}
+		marshXMLres, err := xml.Marshal(DSAssert)
+		require.NoError(t, err)
+		require.Equal(t, XMLMarshalControl, string(marshXMLres))
+	}
+	newCase.ValidateControlTestFunc = func(_ *testing.T, _ interface{}, _ error) {}
+	DatastructuresTest.OutputDataCases[0] = newCase
+	return DatastructuresTest
+}
+
 func TestAllDatastructuresTableCases(t *testing.T) {
 	AllDTTable := initAllDatastructuresTestTable(t)
 	t.Parallel()
@@ -4517,3 +5588,144 @@ func TestAllDatastructuresTableCases(t *testing.T) {
 		}
 	}
 }
+
+func TestMethodRegistry(t *testing.T) {
+	t.Parallel()
+	t.Run("TestMethodRegistry: DefaultMethodDescriptors", func(t *testing.T) {
+		t.Parallel()
+		registry := datastructures.NewDefaultMethodRegistry()
+		descriptors := registry.GetMethods()
+		require.Equal(t, len(datastructures.DefaultMethodDescriptors()), len(descriptors))
+		for _, descriptor := range descriptors {
+			require.NoError(t, descriptor.Validate())
+			require.NotNil(t, descriptor.NewRequest())
+			require.NotNil(t, descriptor.NewResult())
+			if descriptor.LatestDateMethod != "" {
+				latestDateDescriptor, ok := registry.GetMethod(descriptor.LatestDateMethod)
+				require.Equal(t, true, ok)
+				require.Equal(t, true, latestDateDescriptor.LatestDateSource)
+			}
+		}
+	})
+	t.Run("TestMethodRegistry: Register_And_GetMethod", func(t *testing.T) {
+		t.Parallel()
+		registry := datastructures.NewMethodRegistry()
+		descriptor := datastructures.MethodDescriptor{
+			Name:          "KeyRateXML",
+			SOAPMethod:    "KeyRateXML",
+			StartNodeName: "KeyRate",
+			NewRequest:    func() datastructures.RequestData { return &datastructures.KeyRateXML{} },
+			NewResult:     func() interface{} { return &datastructures.KeyRateXMLResult{} },
+		}
+		require.NoError(t, registry.Register(descriptor))
+		require.ErrorIs(t, registry.Register(descriptor), datastructures.ErrMethodAlreadyRegistered)
+		require.ErrorIs(t, registry.Register(datastructures.MethodDescriptor{Name: "void"}), datastructures.ErrVoidMethodDescriptor)
+		registeredDescriptor, ok := registry.GetMethod("KeyRateXML")
+		require.Equal(t, true, ok)
+		require.Equal(t, "KeyRate", registeredDescriptor.StartNodeName)
+		_, ok = registry.GetMethod("UnknownXML")
+		require.Equal(t, false, ok)
+		require.Equal(t, 1, len(registry.GetMethods()))
+	})
+}
+
+func TestCacheKey(t *testing.T) {
+	t.Parallel()
+	t.Run("TestCacheKey: KeyIndependentOfClientJSON", func(t *testing.T) {
+		t.Parallel()
+		rawBodies := []string{
+			`{"FromDate":"2023-06-22","ToDate":"2023-06-23"}`,
+			`{ "ToDate" : "2023-06-23",  "FromDate": "2023-06-22" }`,
+			`{"todate":"2023-06-23","fromdate":" 2023-06-22 "}`,
+		}
+		keys := make([]string, 0, len(rawBodies))
+		for _, rawBody := range rawBodies {
+			request := datastructures.KeyRateXML{}
+			require.NoError(t, json.Unmarshal([]byte(rawBody), &request))
+			datastructures.NormalizeRequest(&request)
+			require.NoError(t, request.Validate())
+			key, err := datastructures.CacheKey("KeyRateXML", &request)
+			require.NoError(t, err)
+			keys = append(keys, key)
+		}
+		require.Equal(t, `KeyRateXML{"FromDate":"2023-06-22","ToDate":"2023-06-23"}`, keys[0])
+		require.Equal(t, keys[0], keys[1])
+		require.Equal(t, keys[0], keys[2])
+	})
+	t.Run("TestCacheKey: KeyOfMethodWithoutParams", func(t *testing.T) {
+		t.Parallel()
+		request := datastructures.AllDataInfoXML{}
+		request.Init()
+		key, err := datastructures.CacheKey("AllDataInfoXML", &request)
+		require.NoError(t, err)
+		require.Equal(t, "AllDataInfoXML", key)
+	})
+}
+
+func TestRequestEndDate(t *testing.T) {
+	t.Parallel()
+	endDate, ok := datastructures.RequestEndDate(&datastructures.KeyRateXML{FromDate: "2023-06-22", ToDate: "2023-06-23"})
+	require.Equal(t, true, ok)
+	require.Equal(t, time.Date(2023, 6, 23, 0, 0, 0, 0, time.UTC), endDate)
+	endDate, ok = datastructures.RequestEndDate(&datastructures.GetCursOnDateXML{OnDate: "2023-06-22"})
+	require.Equal(t, true, ok)
+	require.Equal(t, time.Date(2023, 6, 22, 0, 0, 0, 0, time.UTC), endDate)
+	_, ok = datastructures.RequestEndDate(&datastructures.AllDataInfoXML{})
+	require.Equal(t, false, ok)
+}
+
+func TestDateRangeResults(t *testing.T) {
+	t.Parallel()
+	day1 := time.Date(2023, 6, 22, 0, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	day2 := day1.AddDate(0, 0, 1)
+	result := datastructures.RuoniaXMLResult{Ro: []datastructures.RuoniaXMLResultElem{
+		{D0: day1, Ruo: "7.1500"},
+		{D0: day2, Ruo: "7.1300"},
+	}}
+	days, err := datastructures.SplitResultByDay(result, "D0")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(days))
+	require.Equal(t, datastructures.RuoniaXMLResult{Ro: result.Ro[1:]}, days["2023-06-23"])
+	merged, err := datastructures.MergeResults([]interface{}{days["2023-06-22"], days["2023-06-23"]}, "D0")
+	require.NoError(t, err)
+	require.Equal(t, result, merged)
+	_, err = datastructures.MergeResults([]interface{}{days["2023-06-22"], datastructures.BiCurBaseXMLResult{}}, "D0")
+	require.Error(t, err)
+
+	request := datastructures.RuoniaXML{FromDate: "2023-06-22", ToDate: "2023-06-23"}
+	datastructures.SetRequestDateRange(&request, day2, day2)
+	fromDate, toDate, ok := datastructures.RequestDateRange(&request)
+	require.Equal(t, true, ok)
+	require.Equal(t, "2023-06-23", datastructures.InputDate(fromDate))
+	require.Equal(t, "2023-06-23", datastructures.InputDate(toDate))
+
+	descriptor := datastructures.MethodDescriptor{
+		Name:           "GetCursOnDateXML",
+		SOAPMethod:     "GetCursOnDateXML",
+		NewRequest:     func() datastructures.RequestData { return &datastructures.GetCursOnDateXML{} },
+		NewResult:      func() interface{} { return &datastructures.GetCursOnDateXMLResult{} },
+		RangeDateField: "OnDate",
+	}
+	require.ErrorIs(t, descriptor.Validate(), datastructures.ErrBadRangeMethod)
+	descriptor = datastructures.MethodDescriptor{
+		Name:           "RuoniaXML",
+		SOAPMethod:     "RuoniaXML",
+		NewRequest:     func() datastructures.RequestData { return &datastructures.RuoniaXML{} },
+		NewResult:      func() interface{} { return &datastructures.RuoniaXMLResult{} },
+		RangeDateField: "Ruo",
+	}
+	require.ErrorIs(t, descriptor.Validate(), datastructures.ErrBadRangeMethod)
+	descriptor.RangeDateField = "D0"
+	require.NoError(t, descriptor.Validate())
+}
+
+func TestIsEmptyResult(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, true, datastructures.IsEmptyResult(datastructures.KeyRateXMLResult{}))
+	require.Equal(t, true, datastructures.IsEmptyResult(datastructures.GetCursOnDateXMLResult{OnDate: "20230622"}))
+	require.Equal(t, false, datastructures.IsEmptyResult(datastructures.GetCursOnDateXMLResult{
+		ValuteCursOnDate: []datastructures.GetCursOnDateXMLResultElem{{VchCode: "AUD"}},
+	}))
+	require.Equal(t, true, datastructures.IsEmptyResult(datastructures.LatestDateTimeResult{}))
+	require.Equal(t, false, datastructures.IsEmptyResult(datastructures.LatestDateTimeResult{LatestDateTime: "2023-06-22T00:00:00+03:00"}))
+}
@@ -0,0 +1,118 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Fields of the request date range.
+const (
+	fromDateField = "FromDate"
+	toDateField   = "ToDate"
+)
+
+var ErrBadRangeMethod = errors.New("method can not be split by date range")
+
+// validateRangeMethod checks, that the request has the date range and the result is one list of elements with the date field.
+func validateRangeMethod(descriptor *MethodDescriptor) error {
+	request := reflect.ValueOf(descriptor.NewRequest()).Elem()
+	for _, name := range []string{fromDateField, toDateField} {
+		field := request.FieldByName(name)
+		if !field.IsValid() || field.Kind() != reflect.String {
+			return fmt.Errorf("%w: %s: no %s in request", ErrBadRangeMethod, descriptor.Name, name)
+		}
+	}
+	_, err := resultElements(reflect.ValueOf(descriptor.NewResult()).Elem(), descriptor.RangeDateField)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %s", ErrBadRangeMethod, descriptor.Name, err.Error())
+	}
+	return nil
+}
+
+// resultElements returns the list of elements of the result, elements must have the time field named dateField.
+func resultElements(result reflect.Value, dateField string) (reflect.Value, error) {
+	if result.Kind() != reflect.Struct || result.NumField() != 1 || result.Field(0).Kind() != reflect.Slice {
+		return reflect.Value{}, errors.New("result is not one list of elements")
+	}
+	elements := result.Field(0)
+	elemType := elements.Type().Elem()
+	if elemType.Kind() != reflect.Struct {
+		return reflect.Value{}, errors.New("element of result is not a structure")
+	}
+	field, ok := elemType.FieldByName(dateField)
+	if !ok || field.Type != reflect.TypeOf(time.Time{}) {
+		return reflect.Value{}, errors.New("no time field " + dateField + " in element of result")
+	}
+	return elements, nil
+}
+
+// RequestDateRange returns FromDate and ToDate of the request.
+func RequestDateRange(request RequestData) (time.Time, time.Time, bool) {
+	value := reflect.ValueOf(request).Elem()
+	fromDate, errFrom := time.Parse(inputDTLayout, value.FieldByName(fromDateField).String())
+	toDate, errTo := time.Parse(inputDTLayout, value.FieldByName(toDateField).String())
+	if errFrom != nil || errTo != nil {
+		return fromDate, toDate, false
+	}
+	return fromDate, toDate, true
+}
+
+// SetRequestDateRange sets FromDate and ToDate of the request, other params are not changed.
+func SetRequestDateRange(request RequestData, fromDate time.Time, toDate time.Time) {
+	value := reflect.ValueOf(request).Elem()
+	value.FieldByName(fromDateField).SetString(fromDate.Format(inputDTLayout))
+	value.FieldByName(toDateField).SetString(toDate.Format(inputDTLayout))
+}
+
+// SplitResultByDay splits the result by the date field of its elements, every day is the result of the same type.
+// Result is by value, keys are days in the input layout.
+func SplitResultByDay(result interface{}, dateField string) (map[string]interface{}, error) {
+	elements, err := resultElements(reflect.ValueOf(result), dateField)
+	if err != nil {
+		return nil, err
+	}
+	days := make(map[string]reflect.Value)
+	for i := 0; i < elements.Len(); i++ {
+		element := elements.Index(i)
+		day := element.FieldByName(dateField).Interface().(time.Time).Format(inputDTLayout)
+		dayElements, ok := days[day]
+		if !ok {
+			dayElements = reflect.MakeSlice(elements.Type(), 0, 1)
+		}
+		days[day] = reflect.Append(dayElements, element)
+	}
+	dayResults := make(map[string]interface{}, len(days))
+	for day, dayElements := range days {
+		dayResult := reflect.New(reflect.TypeOf(result)).Elem()
+		dayResult.Field(0).Set(dayElements)
+		dayResults[day] = dayResult.Interface()
+	}
+	return dayResults, nil
+}
+
+// MergeResults concatenates elements of the results in the order of the results, all results must be of the same type.
+func MergeResults(results []interface{}, dateField string) (interface{}, error) {
+	if len(results) == 0 {
+		return nil, errors.New("no results to merge")
+	}
+	merged := reflect.New(reflect.TypeOf(results[0])).Elem()
+	mergedElements, err := resultElements(merged, dateField)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if reflect.TypeOf(result) != merged.Type() {
+			return nil, fmt.Errorf("merge of %T and %s", result, merged.Type())
+		}
+		mergedElements = reflect.AppendSlice(mergedElements, reflect.ValueOf(result).Field(0))
+	}
+	merged.Field(0).Set(mergedElements)
+	return merged.Interface(), nil
+}
+
+// InputDate formats the date in the input layout.
+func InputDate(date time.Time) string {
+	return date.Format(inputDTLayout)
+}
@@ -0,0 +1,360 @@
+// Code generated by wsdlgen from wsdl/DailyInfo.wsdl, wsdl/DailyInfoResults.xsd and wsdl/methods.json. DO NOT EDIT.
+
+package datastructures
+
+func DefaultMethodDescriptors() []MethodDescriptor {
+	return []MethodDescriptor{
+		{
+			Name:          "AllDataInfoXML",
+			SOAPMethod:    "AllDataInfoXML",
+			StartNodeName: "AllData",
+			NewRequest:    func() RequestData { return &AllDataInfoXML{} },
+			NewResult:     func() interface{} { return &AllDataInfoXMLResult{} },
+			WithoutParams: true,
+		},
+		{
+			Name:           "BiCurBaseXML",
+			SOAPMethod:     "BiCurBaseXML",
+			StartNodeName:  "BiCurBase",
+			NewRequest:     func() RequestData { return &BiCurBaseXML{} },
+			NewResult:      func() interface{} { return &BiCurBaseXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "BliquidityXML",
+			SOAPMethod:     "BliquidityXML",
+			StartNodeName:  "Bliquidity",
+			NewRequest:     func() RequestData { return &BliquidityXML{} },
+			NewResult:      func() interface{} { return &BliquidityXMLResult{} },
+			RangeDateField: "DT",
+		},
+		{
+			Name:           "CoinsBaseXML",
+			SOAPMethod:     "Coins_baseXML",
+			StartNodeName:  "Coins_base",
+			NewRequest:     func() RequestData { return &Coins_baseXML{} },
+			NewResult:      func() interface{} { return &Coins_baseXMLResult{} },
+			RangeDateField: "Date",
+		},
+		{
+			Name:           "DepoDynamicXML",
+			SOAPMethod:     "DepoDynamicXML",
+			StartNodeName:  "DepoDynamic",
+			NewRequest:     func() RequestData { return &DepoDynamicXML{} },
+			NewResult:      func() interface{} { return &DepoDynamicXMLResult{} },
+			RangeDateField: "DateDepo",
+		},
+		{
+			Name:           "DragMetDynamicXML",
+			SOAPMethod:     "DragMetDynamicXML",
+			StartNodeName:  "DragMetall",
+			NewRequest:     func() RequestData { return &DragMetDynamicXML{} },
+			NewResult:      func() interface{} { return &DragMetDynamicXMLResult{} },
+			RangeDateField: "DateMet",
+		},
+		{
+			Name:           "DVXML",
+			SOAPMethod:     "DVXML",
+			StartNodeName:  "DV_base",
+			NewRequest:     func() RequestData { return &DVXML{} },
+			NewResult:      func() interface{} { return &DVXMLResult{} },
+			RangeDateField: "Date",
+		},
+		{
+			Name:          "EnumReutersValutesXML",
+			SOAPMethod:    "EnumReutersValutesXML",
+			StartNodeName: "ReutersValutesList",
+			NewRequest:    func() RequestData { return &EnumReutersValutesXML{} },
+			NewResult:     func() interface{} { return &EnumReutersValutesXMLResult{} },
+			WithoutParams: true,
+		},
+		{
+			Name:          "EnumValutesXML",
+			SOAPMethod:    "EnumValutesXML",
+			StartNodeName: "ValuteData",
+			NewRequest:    func() RequestData { return &EnumValutesXML{} },
+			NewResult:     func() interface{} { return &EnumValutesXMLResult{} },
+			PostProcess:   PostProcessEnumValutesXML,
+		},
+		{
+			Name:           "FixingBaseXML",
+			SOAPMethod:     "FixingBaseXML",
+			StartNodeName:  "FixingBase",
+			NewRequest:     func() RequestData { return &FixingBaseXML{} },
+			NewResult:      func() interface{} { return &FixingBaseXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:             "GetCursDynamicXML",
+			SOAPMethod:       "GetCursDynamicXML",
+			StartNodeName:    "ValuteData",
+			NewRequest:       func() RequestData { return &GetCursDynamicXML{} },
+			NewResult:        func() interface{} { return &GetCursDynamicXMLResult{} },
+			PostProcess:      PostProcessGetCursDynamicXML,
+			LatestDateMethod: "GetLatestDateTime",
+			RangeDateField:   "CursDate",
+		},
+		{
+			Name:             "GetCursOnDateXML",
+			SOAPMethod:       "GetCursOnDateXML",
+			StartNodeName:    "ValuteData",
+			NewRequest:       func() RequestData { return &GetCursOnDateXML{} },
+			NewResult:        func() interface{} { return &GetCursOnDateXMLResult{} },
+			PostProcess:      PostProcessGetCursOnDateXML,
+			LatestDateMethod: "GetLatestDateTime",
+		},
+		{
+			Name:             "GetLatestDateTime",
+			SOAPMethod:       "GetLatestDateTime",
+			StartNodeName:    "GetLatestDateTimeResult",
+			NewRequest:       func() RequestData { return &GetLatestDateTime{} },
+			NewResult:        func() interface{} { return &LatestDateTimeResult{} },
+			PostProcess:      PostProcessLatestDateTime,
+			WithoutParams:    true,
+			NotCached:        true,
+			LatestDateSource: true,
+		},
+		{
+			Name:             "GetLatestDateTimeSeld",
+			SOAPMethod:       "GetLatestDateTimeSeld",
+			StartNodeName:    "GetLatestDateTimeSeldResult",
+			NewRequest:       func() RequestData { return &GetLatestDateTimeSeld{} },
+			NewResult:        func() interface{} { return &LatestDateTimeResult{} },
+			PostProcess:      PostProcessLatestDateTime,
+			WithoutParams:    true,
+			NotCached:        true,
+			LatestDateSource: true,
+		},
+		{
+			Name:             "GetLatestReutersDateTime",
+			SOAPMethod:       "GetLatestReutersDateTime",
+			StartNodeName:    "GetLatestReutersDateTimeResult",
+			NewRequest:       func() RequestData { return &GetLatestReutersDateTime{} },
+			NewResult:        func() interface{} { return &LatestDateTimeResult{} },
+			PostProcess:      PostProcessLatestDateTime,
+			WithoutParams:    true,
+			NotCached:        true,
+			LatestDateSource: true,
+		},
+		{
+			Name:             "GetReutersCursOnDateXML",
+			SOAPMethod:       "GetReutersCursOnDateXML",
+			StartNodeName:    "ReutersValutesData",
+			NewRequest:       func() RequestData { return &GetReutersCursOnDateXML{} },
+			NewResult:        func() interface{} { return &GetReutersCursOnDateXMLResult{} },
+			LatestDateMethod: "GetLatestReutersDateTime",
+		},
+		{
+			Name:             "GetSeldCursDynamicXML",
+			SOAPMethod:       "GetSeldCursDynamicXML",
+			StartNodeName:    "ValuteData",
+			NewRequest:       func() RequestData { return &GetSeldCursDynamicXML{} },
+			NewResult:        func() interface{} { return &GetSeldCursDynamicXMLResult{} },
+			PostProcess:      PostProcessGetSeldCursDynamicXML,
+			LatestDateMethod: "GetLatestDateTimeSeld",
+			RangeDateField:   "CursDate",
+		},
+		{
+			Name:             "GetSeldCursOnDateXML",
+			SOAPMethod:       "GetSeldCursOnDateXML",
+			StartNodeName:    "ValuteData",
+			NewRequest:       func() RequestData { return &GetSeldCursOnDateXML{} },
+			NewResult:        func() interface{} { return &GetSeldCursOnDateXMLResult{} },
+			PostProcess:      PostProcessGetSeldCursOnDateXML,
+			LatestDateMethod: "GetLatestDateTimeSeld",
+		},
+		{
+			Name:           "KeyRateXML",
+			SOAPMethod:     "KeyRateXML",
+			StartNodeName:  "KeyRate",
+			NewRequest:     func() RequestData { return &KeyRateXML{} },
+			NewResult:      func() interface{} { return &KeyRateXMLResult{} },
+			RangeDateField: "DT",
+		},
+		{
+			Name:          "MainInfoXML",
+			SOAPMethod:    "MainInfoXML",
+			StartNodeName: "RegData",
+			NewRequest:    func() RequestData { return &MainInfoXML{} },
+			NewResult:     func() interface{} { return &MainInfoXMLResult{} },
+			WithoutParams: true,
+		},
+		{
+			Name:           "MKRXML",
+			SOAPMethod:     "MKRXML",
+			StartNodeName:  "mkr_base",
+			NewRequest:     func() RequestData { return &MKRXML{} },
+			NewResult:      func() interface{} { return &MKRXMLResult{} },
+			RangeDateField: "CDate",
+		},
+		{
+			Name:           "mrrf7DXML",
+			SOAPMethod:     "mrrf7DXML",
+			StartNodeName:  "mmrf7d",
+			NewRequest:     func() RequestData { return &Mrrf7DXML{} },
+			NewResult:      func() interface{} { return &Mrrf7DXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "mrrfXML",
+			SOAPMethod:     "mrrfXML",
+			StartNodeName:  "mmrf",
+			NewRequest:     func() RequestData { return &MrrfXML{} },
+			NewResult:      func() interface{} { return &MrrfXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "NewsInfoXML",
+			SOAPMethod:     "NewsInfoXML",
+			StartNodeName:  "NewsInfo",
+			NewRequest:     func() RequestData { return &NewsInfoXML{} },
+			NewResult:      func() interface{} { return &NewsInfoXMLResult{} },
+			PostProcess:    PostProcessNewsInfoXML,
+			RangeDateField: "DocDate",
+		},
+		{
+			Name:          "OmodInfoXML",
+			SOAPMethod:    "OmodInfoXML",
+			StartNodeName: "OMO",
+			NewRequest:    func() RequestData { return &OmodInfoXML{} },
+			NewResult:     func() interface{} { return &OmodInfoXMLResult{} },
+			WithoutParams: true,
+		},
+		{
+			Name:           "OstatDepoNewXML",
+			SOAPMethod:     "OstatDepoNewXML",
+			StartNodeName:  "OD",
+			NewRequest:     func() RequestData { return &OstatDepoNewXML{} },
+			NewResult:      func() interface{} { return &OstatDepoNewXMLResult{} },
+			RangeDateField: "DT",
+		},
+		{
+			Name:           "OstatDepoXML",
+			SOAPMethod:     "OstatDepoXML",
+			StartNodeName:  "OD",
+			NewRequest:     func() RequestData { return &OstatDepoXML{} },
+			NewResult:      func() interface{} { return &OstatDepoXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "OstatDynamicXML",
+			SOAPMethod:     "OstatDynamicXML",
+			StartNodeName:  "OstatDynamic",
+			NewRequest:     func() RequestData { return &OstatDynamicXML{} },
+			NewResult:      func() interface{} { return &OstatDynamicXMLResult{} },
+			RangeDateField: "DateOst",
+		},
+		{
+			Name:           "OvernightXML",
+			SOAPMethod:     "OvernightXML",
+			StartNodeName:  "Overnight",
+			NewRequest:     func() RequestData { return &OvernightXML{} },
+			NewResult:      func() interface{} { return &OvernightXMLResult{} },
+			RangeDateField: "Date",
+		},
+		{
+			Name:           "RepoDebtXML",
+			SOAPMethod:     "Repo_debtXML",
+			StartNodeName:  "Repo_debt",
+			NewRequest:     func() RequestData { return &Repo_debtXML{} },
+			NewResult:      func() interface{} { return &Repo_debtXMLResult{} },
+			RangeDateField: "Date",
+		},
+		{
+			Name:           "RepoDebtUSDXML",
+			SOAPMethod:     "RepoDebtUSDXML",
+			StartNodeName:  "RepoDebtUSD",
+			NewRequest:     func() RequestData { return &RepoDebtUSDXML{} },
+			NewResult:      func() interface{} { return &RepoDebtUSDXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "ROISfixXML",
+			SOAPMethod:     "ROISfixXML",
+			StartNodeName:  "ROISfix",
+			NewRequest:     func() RequestData { return &ROISfixXML{} },
+			NewResult:      func() interface{} { return &ROISfixXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "RuoniaSVXML",
+			SOAPMethod:     "RuoniaSVXML",
+			StartNodeName:  "RuoniaSV",
+			NewRequest:     func() RequestData { return &RuoniaSVXML{} },
+			NewResult:      func() interface{} { return &RuoniaSVXMLResult{} },
+			RangeDateField: "DT",
+		},
+		{
+			Name:           "RuoniaXML",
+			SOAPMethod:     "RuoniaXML",
+			StartNodeName:  "Ruonia",
+			NewRequest:     func() RequestData { return &RuoniaXML{} },
+			NewResult:      func() interface{} { return &RuoniaXMLResult{} },
+			RangeDateField: "D0",
+		},
+		{
+			Name:           "SaldoXML",
+			SOAPMethod:     "SaldoXML",
+			StartNodeName:  "Saldo",
+			NewRequest:     func() RequestData { return &SaldoXML{} },
+			NewResult:      func() interface{} { return &SaldoXMLResult{} },
+			RangeDateField: "Dt",
+		},
+		{
+			Name:            "SwapDayTotalXML",
+			SOAPMethod:      "SwapDayTotalXML",
+			StartNodeName:   "SwapDayTotal",
+			NewRequest:      func() RequestData { return &SwapDayTotalXML{} },
+			NewResult:       func() interface{} { return &SwapDayTotalXMLResult{} },
+			RangeDateField:  "DT",
+			RangeDescending: true,
+		},
+		{
+			Name:          "SwapDynamicXML",
+			SOAPMethod:    "SwapDynamicXML",
+			StartNodeName: "SwapDynamic",
+			NewRequest:    func() RequestData { return &SwapDynamicXML{} },
+			NewResult:     func() interface{} { return &SwapDynamicXMLResult{} },
+		},
+		{
+			Name:            "SwapInfoSellUSDVolXML",
+			SOAPMethod:      "SwapInfoSellUSDVolXML",
+			StartNodeName:   "SwapInfoSellUSDVol",
+			NewRequest:      func() RequestData { return &SwapInfoSellUSDVolXML{} },
+			NewResult:       func() interface{} { return &SwapInfoSellUSDVolXMLResult{} },
+			RangeDateField:  "DT",
+			RangeDescending: true,
+		},
+		{
+			Name:          "SwapInfoSellUSDXML",
+			SOAPMethod:    "SwapInfoSellUSDXML",
+			StartNodeName: "swapinfosellusd",
+			NewRequest:    func() RequestData { return &SwapInfoSellUSDXML{} },
+			NewResult:     func() interface{} { return &SwapInfoSellUSDXMLResult{} },
+		},
+		{
+			Name:            "SwapInfoSellVolXML",
+			SOAPMethod:      "SwapInfoSellVolXML",
+			StartNodeName:   "SwapInfoSellVol",
+			NewRequest:      func() RequestData { return &SwapInfoSellVolXML{} },
+			NewResult:       func() interface{} { return &SwapInfoSellVolXMLResult{} },
+			RangeDateField:  "DT",
+			RangeDescending: true,
+		},
+		{
+			Name:          "SwapInfoSellXML",
+			SOAPMethod:    "SwapInfoSellXML",
+			StartNodeName: "SwapInfoSell",
+			NewRequest:    func() RequestData { return &SwapInfoSellXML{} },
+			NewResult:     func() interface{} { return &SwapInfoSellXMLResult{} },
+		},
+		{
+			Name:           "SwapMonthTotalXML",
+			SOAPMethod:     "SwapMonthTotalXML",
+			StartNodeName:  "SwapMonthTotal",
+			NewRequest:     func() RequestData { return &SwapMonthTotalXML{} },
+			NewResult:      func() interface{} { return &SwapMonthTotalXMLResult{} },
+			RangeDateField: "D0",
+		},
+	}
+}
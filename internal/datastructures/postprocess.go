@@ -0,0 +1,79 @@
+package datastructures
+
+import (
+	"strings"
+)
+
+func PostProcessEnumValutesXML(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*EnumValutesXMLResult)
+	if !ok {
+		return
+	}
+	for i := range result.EnumValutes {
+		result.EnumValutes[i].Vcode = strings.TrimSpace(result.EnumValutes[i].Vcode)
+		result.EnumValutes[i].Vname = strings.TrimSpace(result.EnumValutes[i].Vname)
+		result.EnumValutes[i].VEngname = strings.TrimSpace(result.EnumValutes[i].VEngname)
+		result.EnumValutes[i].VcommonCode = strings.TrimSpace(result.EnumValutes[i].VcommonCode)
+	}
+}
+
+func PostProcessGetCursDynamicXML(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*GetCursDynamicXMLResult)
+	if !ok {
+		return
+	}
+	for i := range result.ValuteCursDynamic {
+		result.ValuteCursDynamic[i].Vcode = strings.TrimSpace(result.ValuteCursDynamic[i].Vcode)
+	}
+}
+
+func PostProcessGetCursOnDateXML(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*GetCursOnDateXMLResult)
+	if !ok {
+		return
+	}
+	for i := range result.ValuteCursOnDate {
+		result.ValuteCursOnDate[i].Vname = strings.TrimSpace(result.ValuteCursOnDate[i].Vname)
+		result.ValuteCursOnDate[i].Vname = strings.Trim(result.ValuteCursOnDate[i].Vname, "\r\n")
+	}
+}
+
+func PostProcessLatestDateTime(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*LatestDateTimeResult)
+	if !ok {
+		return
+	}
+	result.LatestDateTime = strings.TrimSpace(result.LatestDateTime)
+}
+
+func PostProcessGetSeldCursDynamicXML(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*GetSeldCursDynamicXMLResult)
+	if !ok {
+		return
+	}
+	for i := range result.ValuteCursDynamic {
+		result.ValuteCursDynamic[i].Vcode = strings.TrimSpace(result.ValuteCursDynamic[i].Vcode)
+	}
+}
+
+func PostProcessGetSeldCursOnDateXML(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*GetSeldCursOnDateXMLResult)
+	if !ok {
+		return
+	}
+	for i := range result.ValuteCursOnDate {
+		result.ValuteCursOnDate[i].Vname = strings.TrimSpace(result.ValuteCursOnDate[i].Vname)
+		result.ValuteCursOnDate[i].Vname = strings.Trim(result.ValuteCursOnDate[i].Vname, "\r\n")
+	}
+}
+
+func PostProcessNewsInfoXML(pointerToResult interface{}) {
+	result, ok := pointerToResult.(*NewsInfoXMLResult)
+	if !ok {
+		return
+	}
+	for i := range result.News {
+		result.News[i].Title = strings.TrimSpace(result.News[i].Title)
+		result.News[i].Url = strings.TrimSpace(result.News[i].Url)
+	}
+}
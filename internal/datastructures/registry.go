@@ -0,0 +1,109 @@
+package datastructures
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrMethodAlreadyRegistered = errors.New("method already registered")
+	ErrVoidMethodDescriptor    = errors.New("void name, SOAP method or constructors in method descriptor")
+)
+
+type RequestData interface {
+	Init()
+	Validate() error
+}
+
+// MethodDescriptor declares everything needed to serve one CBR WS method: routing, validation, SOAP call, decoding and caching.
+type MethodDescriptor struct {
+	// NewRequest returns a pointer to a new request structure.
+	NewRequest func() RequestData
+	// NewResult returns a pointer to a new result structure, which is filled by XML decoding.
+	NewResult func() interface{}
+	// PostProcess is called with the pointer to the decoded result, may be nil.
+	PostProcess func(pointerToResult interface{})
+	// Name is the handler path and the cache tag prefix.
+	Name          string
+	SOAPMethod    string
+	StartNodeName string
+	// LatestDateMethod is the name of the method reporting the latest publication date of this data, may be void.
+	LatestDateMethod string
+	WithoutParams    bool
+	NotCached        bool
+	// LatestDateSource marks methods, which results are tracked as the latest publication dates.
+	LatestDateSource bool
+	// RangeDateField is the date field of result elements of methods, which are cached by days of FromDate-ToDate range, may be void.
+	RangeDateField string
+	// RangeDescending marks methods, which results are ordered from the latest day to the earliest one.
+	RangeDescending bool
+}
+
+func (md *MethodDescriptor) Validate() error {
+	if md.Name == "" || md.SOAPMethod == "" || md.NewRequest == nil || md.NewResult == nil {
+		return ErrVoidMethodDescriptor
+	}
+	if md.RangeDateField != "" {
+		return validateRangeMethod(md)
+	}
+	return nil
+}
+
+type MethodRegistry struct {
+	mu      sync.RWMutex
+	methods map[string]MethodDescriptor
+	order   []string
+}
+
+func NewMethodRegistry() *MethodRegistry {
+	return &MethodRegistry{
+		methods: make(map[string]MethodDescriptor),
+		order:   make([]string, 0),
+	}
+}
+
+func (mr *MethodRegistry) Register(descriptor MethodDescriptor) error {
+	err := descriptor.Validate()
+	if err != nil {
+		return err
+	}
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	_, ok := mr.methods[descriptor.Name]
+	if ok {
+		return ErrMethodAlreadyRegistered
+	}
+	mr.methods[descriptor.Name] = descriptor
+	mr.order = append(mr.order, descriptor.Name)
+	return nil
+}
+
+func (mr *MethodRegistry) GetMethod(name string) (MethodDescriptor, bool) {
+	mr.mu.RLock()
+	defer mr.mu.RUnlock()
+	descriptor, ok := mr.methods[name]
+	return descriptor, ok
+}
+
+// GetMethods returns descriptors in order of registration.
+func (mr *MethodRegistry) GetMethods() []MethodDescriptor {
+	mr.mu.RLock()
+	defer mr.mu.RUnlock()
+	descriptors := make([]MethodDescriptor, 0, len(mr.order))
+	for _, name := range mr.order {
+		descriptors = append(descriptors, mr.methods[name])
+	}
+	return descriptors
+}
+
+// NewDefaultMethodRegistry returns registry with all supported CBR WS methods.
+func NewDefaultMethodRegistry() *MethodRegistry {
+	registry := NewMethodRegistry()
+	for _, descriptor := range DefaultMethodDescriptors() {
+		err := registry.Register(descriptor)
+		if err != nil {
+			panic(err.Error() + ": " + descriptor.Name)
+		}
+	}
+	return registry
+}
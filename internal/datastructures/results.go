@@ -0,0 +1,23 @@
+package datastructures
+
+import "reflect"
+
+// IsEmptyResult reports, that the result has no data: all its lists of elements are empty, attributes like OnDate
+// do not count. Result without lists is empty, if it is zero.
+func IsEmptyResult(result interface{}) bool {
+	value := reflect.ValueOf(result)
+	if value.Kind() != reflect.Struct {
+		return value.IsZero()
+	}
+	hasElements := false
+	for i := 0; i < value.NumField(); i++ {
+		if value.Field(i).Kind() != reflect.Slice {
+			continue
+		}
+		if value.Field(i).Len() > 0 {
+			return false
+		}
+		hasElements = true
+	}
+	return hasElements || value.IsZero()
+}
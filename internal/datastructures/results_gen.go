@@ -0,0 +1,860 @@
+// Code generated by wsdlgen from wsdl/DailyInfo.wsdl, wsdl/DailyInfoResults.xsd and wsdl/methods.json. DO NOT EDIT.
+
+package datastructures
+
+import (
+	"time"
+)
+
+type AllDataInfoXMLResult struct {
+	// AllData node
+	MainIndicatorsVR MainIndicatorsVRElem `xml:"MainIndicatorsVR" json:"MainIndicatorsVR"`
+	KEY_RATE         KEY_RATEElem         `xml:"KEY_RATE" json:"KEY_RATE"`
+	KEY_RATE_FUTURE  KEY_RATE_FUTUREElem  `xml:"KEY_RATE_FUTURE" json:"KEY_RATE_FUTURE"`
+	REF_RATE         TVStElem             `xml:"REF_RATE" json:"REF_RATE"`
+	MBRStavki        MBRStavkiElem        `xml:"MBRStavki" json:"MBRStavki"`
+	Ko               KoElem               `xml:"Ko" json:"Ko"`
+	BankLikvid       BankLikvidElem       `xml:"BankLikvid" json:"BankLikvid"`
+	Nor              NorElem              `xml:"Nor" json:"Nor"`
+	Macro            MacroElem            `xml:"Macro" json:"Macro"`
+}
+
+type VoVStElem struct {
+	Val     string `xml:"val,attr" json:"val"`
+	Old_val string `xml:"old_val,attr" json:"old_val"`
+}
+
+type VStElem struct {
+	Val string `xml:"val,attr" json:"val"`
+}
+
+type TVStElem struct {
+	Title string `xml:"Title,attr" json:"Title"`
+	Val   string `xml:"val,attr" json:"val"`
+}
+
+type TLOVOStElem struct {
+	Title   string `xml:"Title,attr" json:"Title"`
+	LUpd    string `xml:"LUpd,attr" json:"LUpd"`
+	OnDate  string `xml:"OnDate,attr" json:"OnDate"`
+	Val     string `xml:"val,attr" json:"val"`
+	Old_val string `xml:"old_val,attr" json:"old_val"`
+}
+
+type MainIndicatorsVRElem struct {
+	Title           string              `xml:"Title,attr" json:"Title"`
+	Currency        CurrencyElem        `xml:"Currency" json:"Currency"`
+	Metall          MetallElem          `xml:"Metall" json:"Metall"`
+	Inflation       InflationElemADI    `xml:"Inflation" json:"Inflation"`
+	InflationTarget InflationTargetElem `xml:"InflationTarget" json:"InflationTarget"`
+	MBK             MBKElem             `xml:"MBK" json:"MBK"`
+	MosPrime        MosPrimeElem        `xml:"MosPrime" json:"MosPrime"`
+}
+
+type CurrencyElem struct {
+	Title string  `xml:"Title,attr" json:"Title"`
+	LUpd  string  `xml:"LUpd,attr" json:"LUpd"`
+	USD   USDElem `xml:"USD" json:"USD"`
+	EUR   EURElem `xml:"EUR" json:"EUR"`
+	CNY   CNYElem `xml:"CNY" json:"CNY"`
+}
+
+type USDElem struct {
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Curs   string `xml:"curs" json:"curs"`
+}
+
+type EURElem struct {
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Curs   string `xml:"curs" json:"curs"`
+}
+
+type CNYElem struct {
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Curs   string `xml:"curs" json:"curs"`
+}
+
+type MetallElem struct {
+	Title     string    `xml:"Title,attr" json:"Title"`
+	LUpd      string    `xml:"LUpd,attr" json:"LUpd"`
+	OnDate    string    `xml:"OnDate,attr" json:"OnDate"`
+	Gold      VoVStElem `xml:"Золото" json:"Gold"`
+	Silver    VoVStElem `xml:"Серебро" json:"Silver"`
+	Platinum  VoVStElem `xml:"Платина" json:"Platinum"`
+	Palladium VoVStElem `xml:"Палладий" json:"Palladium"`
+}
+
+type InflationElemADI struct {
+	Title  string `xml:"Title,attr" json:"Title"`
+	LUpd   string `xml:"LUpd,attr" json:"LUpd"`
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Val    string `xml:"val,attr" json:"val"`
+}
+
+type InflationTargetElem struct {
+	Title  string `xml:"Title,attr" json:"Title"`
+	LUpd   string `xml:"LUpd,attr" json:"LUpd"`
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Val    string `xml:"val,attr" json:"val"`
+}
+
+type MBKElem struct {
+	Title   string        `xml:"Title,attr" json:"Title"`
+	LUpd    string        `xml:"LUpd,attr" json:"LUpd"`
+	MIBID   MBKStructElem `xml:"MIBID" json:"MIBID"`
+	MIBOR   MBKStructElem `xml:"MIBOR" json:"MIBOR"`
+	MIACR   MBKStructElem `xml:"MIACR" json:"MIACR"`
+	MIACRIG MBKStructElem `xml:"MIACR-IG" json:"MIACRIG"`
+}
+
+type MBKStructElem struct {
+	OnDate string    `xml:"OnDate,attr" json:"OnDate"`
+	D1     VoVStElem `xml:"D1" json:"D1"`
+	D2_7   VoVStElem `xml:"D2_7" json:"D2_7"`
+	D8_30  VoVStElem `xml:"D8_30" json:"D8_30"`
+}
+
+type MosPrimeElem struct {
+	Title  string    `xml:"Title,attr" json:"Title"`
+	LUpd   string    `xml:"LUpd,attr" json:"LUpd"`
+	OnDate string    `xml:"OnDate,attr" json:"OnDate"`
+	D1     VoVStElem `xml:"D1" json:"D1"`
+	M1     VoVStElem `xml:"M1" json:"M1"`
+	M3     VoVStElem `xml:"M3" json:"M3"`
+}
+
+type KEY_RATEElem struct {
+	Title string `xml:"Title,attr" json:"Title"`
+	Val   string `xml:"val,attr" json:"val"`
+	Date  string `xml:"date,attr" json:"date"`
+}
+
+type KEY_RATE_FUTUREElem struct {
+	Title   string `xml:"Title,attr" json:"Title"`
+	Val     string `xml:"val,attr" json:"val"`
+	NewDate string `xml:"newdate,attr" json:"newdate"`
+}
+
+type MBRStavkiElem struct {
+	Title               string               `xml:"Title,attr" json:"Title"`
+	Overnight_rate      Overnight_rateElem   `xml:"Overnight_rate" json:"Overnight_rate"`
+	FixedLomb           FixedLombElem        `xml:"FixedLomb" json:"FixedLomb"`
+	DepoRates           DepoRatesElem        `xml:"DepoRates" json:"DepoRates"`
+	SWAP                SWAPElem             `xml:"SWAP" json:"SWAP"`
+	FixedRepoRate       FixedRepoRateElem    `xml:"FixedRepoRate" json:"FixedRepoRate"`
+	MinimalRepoRates    MinimalRepoRatesElem `xml:"MinimalRepoRates" json:"MinimalRepoRates"`
+	MaxVolRepoOnAuction MaxVolMBRelem        `xml:"MaxVolRepoOnAuction" json:"MaxVolRepoOnAuction"`
+	MaxVolSwap          MaxVolMBRelem        `xml:"MaxVolSwap" json:"MaxVolSwap"`
+}
+
+type Overnight_rateElem struct {
+	Title string    `xml:"Title,attr" json:"Title"`
+	LUpd  string    `xml:"LUpd,attr" json:"LUpd"`
+	Val1  ValORElem `xml:"Val1" json:"Val1"`
+	Val2  ValORElem `xml:"Val2" json:"Val2"`
+}
+
+type ValORElem struct {
+	Date string `xml:"Date,attr" json:"Date"`
+	Val  string `xml:"val,attr" json:"val"`
+}
+
+type FixedLombElem struct {
+	Title string `xml:"Title,attr" json:"Title"`
+	LUpd  string `xml:"LUpd,attr" json:"LUpd"`
+	D30   FLElem `xml:"D30" json:"D30"`
+	D7    FLElem `xml:"D7" json:"D7"`
+	D1    FLElem `xml:"D1" json:"D1"`
+}
+
+type FLElem struct {
+	Date string `xml:"Date,attr" json:"Date"`
+	Val  string `xml:"val,attr" json:"val"`
+}
+
+type DepoRatesElem struct {
+	Title       string    `xml:"Title,attr" json:"Title"`
+	LUpd        string    `xml:"LUpd,attr" json:"LUpd"`
+	OnDate      string    `xml:"OnDate,attr" json:"OnDate"`
+	TomNext     VoVStElem `xml:"TomNext" json:"TomNext"`
+	SpotNext    VoVStElem `xml:"SpotNext" json:"SpotNext"`
+	W1          VoVStElem `xml:"W1" json:"W1"`
+	W1_SPOT     VoVStElem `xml:"W1_SPOT" json:"W1_SPOT"`
+	CallDeposit VoVStElem `xml:"CallDeposit" json:"CallDeposit"`
+}
+
+type SWAPElem struct {
+	Title   string      `xml:"Title,attr" json:"Title"`
+	USD_RUB SWAPCurElem `xml:"USD_RUB" json:"USD_RUB"`
+	EUR_RUB SWAPCurElem `xml:"EUR_RUB" json:"EUR_RUB"`
+}
+
+type SWAPCurElem struct {
+	LUpd    string `xml:"LUpd,attr" json:"LUpd"`
+	Val     string `xml:"val,attr" json:"val"`
+	Old_val string `xml:"old_val,attr" json:"Old_val"`
+}
+
+type FixedRepoRateElem struct {
+	Title string  `xml:"Title,attr" json:"Title"`
+	D1    VStElem `xml:"D1" json:"D1"`
+	D7    VStElem `xml:"D7" json:"D7"`
+}
+
+type MinimalRepoRatesElem struct {
+	Title  string  `xml:"Title,attr" json:"Title"`
+	LUpd   string  `xml:"LUpd,attr" json:"LUpd"`
+	OnDate string  `xml:"OnDate,attr" json:"OnDate"`
+	D1     VStElem `xml:"D1" json:"D1"`
+	D7     VStElem `xml:"D7" json:"D7"`
+}
+
+type MaxVolMBRelem struct {
+	Title  string `xml:"Title,attr" json:"Title"`
+	LUpd   string `xml:"LUpd,attr" json:"LUpd"`
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Val    string `xml:"val,attr" json:"val"`
+}
+
+type KoElem struct {
+	Title             string           `xml:"Title,attr" json:"Title"`
+	OnOvernightCredit TLOVOStElem      `xml:"OnOvernightCredit" json:"OnOvernightCredit"`
+	OnLombardCredit   TLOVOStElem      `xml:"OnLombardCredit" json:"OnLombardCredit"`
+	OnOtherCredit     TLOVOStElem      `xml:"OnOtherCredit" json:"OnOtherCredit"`
+	OnDirectRepo      OnDirectRepoElem `xml:"OnDirectRepo" json:"OnDirectRepo"`
+	UnsecLoans        TLOVOStElem      `xml:"UnsecLoans" json:"UnsecLoans"`
+}
+
+type OnDirectRepoElem struct {
+	Title     string   `xml:"Title,attr" json:"Title"`
+	OnDate    string   `xml:"OnDate,attr" json:"OnDate"`
+	OnAuction TVStElem `xml:"OnAuction" json:"OnAuction"`
+	OnFixed   TVStElem `xml:"OnFixed" json:"OnFixed"`
+}
+
+type BankLikvidElem struct {
+	Title     string      `xml:"Title,attr" json:"Title"`
+	OstatKO   OstatKOElem `xml:"OstatKO" json:"OstatKO"`
+	InDCredit TLOVOStElem `xml:"InDCredit" json:"InDCredit"`
+	DepoBR    TLOVOStElem `xml:"DepoBR" json:"DepoBR"`
+	Saldo     TLOVOStElem `xml:"Saldo" json:"Saldo"`
+	VolOBR    TVStElem    `xml:"VolOBR" json:"VolOBR"`
+	VolDepo   VolDepoElem `xml:"VolDepo" json:"VolDepo"`
+}
+
+type OstatKOElem struct {
+	Title  string    `xml:"Title,attr" json:"Title"`
+	OnDate string    `xml:"OnDate,attr" json:"OnDate"`
+	LUpd   string    `xml:"LUpd,attr" json:"LUpd"`
+	Russ   VoVStElem `xml:"Russ" json:"Russ"`
+	Msk    VoVStElem `xml:"Msk" json:"Msk"`
+}
+
+type VolDepoElem struct {
+	Title  string `xml:"Title,attr" json:"Title"`
+	OnDate string `xml:"OnDate,attr" json:"OnDate"`
+	Val    string `xml:"val,attr" json:"val"`
+}
+
+type NorElem struct {
+	Date  string   `xml:"date,attr" json:"date"`
+	Title string   `xml:"Title,attr" json:"Title"`
+	Ob_1  Ob_1Elem `xml:"Ob_1" json:"Ob_1"`
+	Ob_2  Ob_2Elem `xml:"Ob_2" json:"Ob_2"`
+	Ob_3  Ob_3Elem `xml:"Ob_3" json:"Ob_3"`
+	Kor   KorElem  `xml:"Kor" json:"Kor"`
+}
+
+type Ob_1Elem struct {
+	Title  string        `xml:"Title,attr" json:"Title"`
+	Ob_1_1 NorTLevelelem `xml:"Ob_1_1" json:"Ob_1_1"`
+	Ob_1_2 NorTLevelelem `xml:"Ob_1_2" json:"Ob_1_2"`
+	Ob_1_3 NorTLevelelem `xml:"Ob_1_3" json:"Ob_1_3"`
+}
+
+type Ob_2Elem struct {
+	Title  string        `xml:"Title,attr" json:"Title"`
+	Ob_2_1 NorTLevelelem `xml:"Ob_2_1" json:"Ob_2_1"`
+	Ob_2_2 NorTLevelelem `xml:"Ob_2_2" json:"Ob_2_2"`
+	Ob_2_3 NorTLevelelem `xml:"Ob_2_3" json:"Ob_2_3"`
+}
+
+type Ob_3Elem struct {
+	Title  string        `xml:"Title,attr" json:"Title"`
+	Ob_3_1 NorTLevelelem `xml:"Ob_3_1" json:"Ob_3_1"`
+	Ob_3_2 NorTLevelelem `xml:"Ob_3_2" json:"Ob_3_2"`
+	Ob_3_3 NorTLevelelem `xml:"Ob_3_3" json:"Ob_3_3"`
+}
+
+type NorTLevelelem struct {
+	Title            string `xml:"Title,attr" json:"Title"`
+	Val_rub          string `xml:"val_rub,attr" json:"val_rub"`
+	Val_usd          string `xml:"val_usd,attr" json:"val_usd"`
+	Val_usd_excludUC string `xml:"val_usd_excludUC,attr" json:"val_usd_excludUC"`
+}
+
+type KorElem struct {
+	Title string   `xml:"Title,attr" json:"Title"`
+	Ku_1  TVStElem `xml:"Ku_1" json:"Ku_1"`
+	Ku_2  TVStElem `xml:"Ku_2" json:"Ku_2"`
+}
+
+type MacroElem struct {
+	Title       string    `xml:"Title,attr" json:"Title"`
+	DB          TVStElem  `xml:"DB" json:"DB"`
+	DM          TVStElem  `xml:"DM" json:"DM"`
+	M_rez       M_rezElem `xml:"M_rez" json:"M_rez"`
+	Vol_GKO_OFZ TVStElem  `xml:"Vol_GKO_OFZ" json:"Vol_GKO_OFZ"`
+}
+
+type M_rezElem struct {
+	Title string `xml:"Title,attr" json:"Title"`
+	Val   string `xml:"val,attr" json:"val"`
+	Date  string `xml:"date,attr" json:"date"`
+}
+
+type BiCurBaseXMLResult struct {
+	// BiCurBase node
+	BCB []BiCurBaseXMLResultElem `xml:"BCB" json:"BCB"`
+}
+
+type BiCurBaseXMLResultElem struct {
+	D0  time.Time `xml:"D0" json:"D0"`
+	VAL string    `xml:"VAL" json:"VAL"`
+}
+
+type BliquidityXMLResult struct {
+	// Bliquidity node
+	BL []BliquidityXMLResultElem `xml:"BL" json:"BL"`
+}
+
+type BliquidityXMLResultElem struct {
+	DT                            time.Time `xml:"DT" json:"DT"`
+	StrLiDef                      string    `xml:"StrLiDef" json:"StrLiDef"`
+	Claims                        string    `xml:"claims" json:"claims"`
+	ActionBasedRepoFX             string    `xml:"actionBasedRepoFX" json:"actionBasedRepoFX"`
+	ActionBasedSecureLoans        string    `xml:"actionBasedSecureLoans" json:"actionBasedSecureLoans"`
+	StandingFacilitiesRepoFX      string    `xml:"standingFacilitiesRepoFX" json:"standingFacilitiesRepoFX"`
+	StandingFacilitiesSecureLoans string    `xml:"standingFacilitiesSecureLoans" json:"standingFacilitiesSecureLoans"`
+	Liabilities                   string    `xml:"liabilities" json:"liabilities"`
+	DepositAuctionBased           string    `xml:"depositAuctionBased" json:"depositAuctionBased"`
+	DepositStandingFacilities     string    `xml:"depositStandingFacilities" json:"depositStandingFacilities"`
+	CBRbonds                      string    `xml:"CBRbonds" json:"CBRbonds"`
+	NetCBRclaims                  string    `xml:"netCBRclaims" json:"netCBRclaims"`
+}
+
+type Coins_baseXMLResult struct {
+	// Coins_base node
+	CB []Coins_baseXMLResultElem `xml:"CB" json:"CB"`
+}
+
+type Coins_baseXMLResultElem struct {
+	Date       time.Time `xml:"date" json:"date"`
+	Cat_number string    `xml:"Cat_number" json:"Cat_number"`
+	Price      string    `xml:"price" json:"price"`
+}
+
+type DepoDynamicXMLResult struct {
+	// DepoDynamic node
+	Depo []DepoDynamicXMLResultElem `xml:"Depo" json:"Depo"`
+}
+
+type DepoDynamicXMLResultElem struct {
+	DateDepo  time.Time `xml:"DateDepo" json:"DateDepo"`
+	Overnight string    `xml:"Overnight" json:"Overnight"`
+}
+
+type DragMetDynamicXMLResult struct {
+	// DragMetall node
+	DrgMet []DragMetDynamicXMLResultElem `xml:"DrgMet" json:"DrgMet"`
+}
+
+type DragMetDynamicXMLResultElem struct {
+	DateMet time.Time `xml:"DateMet" json:"DateMet"`
+	CodMet  string    `xml:"CodMet" json:"CodMet"`
+	Price   string    `xml:"price" json:"price"`
+}
+
+type DVXMLResult struct {
+	// DV_base node
+	DV []DVXMLResultElem `xml:"DV" json:"DV"`
+}
+
+type DVXMLResultElem struct {
+	Date     time.Time `xml:"Date" json:"Date"`
+	VOvern   string    `xml:"VOvern" json:"VOvern"`
+	VLomb    string    `xml:"VLomb" json:"VLomb"`
+	VIDay    string    `xml:"VIDay" json:"VIDay"`
+	VOther   string    `xml:"VOther" json:"VOther"`
+	Vol_Gold string    `xml:"Vol_Gold" json:"Vol_Gold"`
+	VIDate   time.Time `xml:"VIDate" json:"VIDate"`
+}
+
+type EnumReutersValutesXMLResult struct {
+	// ReutersValutesList node
+	EnumRValutes []EnumReutersValutesXMLResultElem `xml:"EnumRValutes" json:"EnumRValutes"`
+}
+
+type EnumReutersValutesXMLResultElem struct {
+	Num_code  int32  `xml:"num_code" json:"num_code"`
+	Char_code string `xml:"char_code" json:"char_code"`
+	Title_ru  string `xml:"Title_ru" json:"Title_ru"`
+	Title_en  string `xml:"Title_en" json:"Title_en"`
+}
+
+type EnumValutesXMLResult struct {
+	// ValuteData node
+	EnumValutes []EnumValutesXMLResultElem `xml:"EnumValutes" json:"EnumValutes"`
+}
+
+type EnumValutesXMLResultElem struct {
+	Vcode       string `xml:"Vcode" json:"Vcode"`
+	Vname       string `xml:"Vname" json:"Vname"`
+	VEngname    string `xml:"VEngname" json:"VEngname"`
+	Vnom        int32  `xml:"Vnom" json:"Vnom"`
+	VcommonCode string `xml:"VcommonCode" json:"VcommonCode"`
+	VnumCode    int32  `xml:"VnumCode" json:"VnumCode"`
+	VcharCode   string `xml:"VcharCode" json:"VcharCode"`
+}
+
+type FixingBaseXMLResult struct {
+	// FixingBase node
+	FB []FixingBaseXMLResultElem `xml:"FB" json:"FB"`
+}
+
+type FixingBaseXMLResultElem struct {
+	D0     time.Time `xml:"D0" json:"D0"`
+	CodMet string    `xml:"CodMet" json:"CodMet"`
+	Price  string    `xml:"price" json:"price"`
+}
+
+type GetCursDynamicXMLResult struct {
+	// ValuteData node
+	ValuteCursDynamic []GetCursDynamicXMLResultElem `xml:"ValuteCursDynamic" json:"ValuteCursDynamic"`
+}
+
+type GetCursDynamicXMLResultElem struct {
+	CursDate  time.Time `xml:"CursDate" json:"CursDate"`
+	Vcode     string    `xml:"Vcode" json:"Vcode"`
+	Vnom      int32     `xml:"Vnom" json:"Vnom"`
+	Vcurs     string    `xml:"Vcurs" json:"Vcurs"`
+	VunitRate string    `xml:"VunitRate" json:"VunitRate"`
+}
+
+type GetCursOnDateXMLResult struct {
+	// ValuteData node
+	OnDate           string                       `xml:"OnDate,attr" json:"OnDate"`
+	ValuteCursOnDate []GetCursOnDateXMLResultElem `xml:"ValuteCursOnDate" json:"ValuteCursOnDate"`
+}
+
+type GetCursOnDateXMLResultElem struct {
+	Vname   string `xml:"Vname" json:"Vname"`
+	Vnom    int32  `xml:"Vnom" json:"Vnom"`
+	Vcurs   string `xml:"Vcurs" json:"Vcurs"`
+	Vcode   string `xml:"Vcode" json:"Vcode"`
+	VchCode string `xml:"VchCode" json:"VchCode"`
+}
+
+type LatestDateTimeResult struct {
+	// GetLatestDateTimeResult, GetLatestDateTimeSeldResult, GetLatestReutersDateTimeResult nodes
+	LatestDateTime string `xml:",chardata" json:"LatestDateTime"`
+}
+
+type GetReutersCursOnDateXMLResult struct {
+	// ReutersValutesData node
+	OnDate   string                              `xml:"OnDate,attr" json:"OnDate"`
+	Currency []GetReutersCursOnDateXMLResultElem `xml:"Currency" json:"Currency"`
+}
+
+type GetReutersCursOnDateXMLResultElem struct {
+	Num_code int32  `xml:"num_code" json:"num_code"`
+	Val      string `xml:"val" json:"val"`
+	Dir      int32  `xml:"dir" json:"dir"`
+}
+
+type GetSeldCursDynamicXMLResult struct {
+	// ValuteData node
+	ValuteCursDynamic []GetSeldCursDynamicXMLResultElem `xml:"ValuteCursDynamic" json:"ValuteCursDynamic"`
+}
+
+type GetSeldCursDynamicXMLResultElem struct {
+	CursDate  time.Time `xml:"CursDate" json:"CursDate"`
+	Vcode     string    `xml:"Vcode" json:"Vcode"`
+	Vnom      int32     `xml:"Vnom" json:"Vnom"`
+	Vcurs     string    `xml:"Vcurs" json:"Vcurs"`
+	VunitRate string    `xml:"VunitRate" json:"VunitRate"`
+}
+
+type GetSeldCursOnDateXMLResult struct {
+	// ValuteData node
+	OnDate           string                           `xml:"OnDate,attr" json:"OnDate"`
+	ValuteCursOnDate []GetSeldCursOnDateXMLResultElem `xml:"ValuteCursOnDate" json:"ValuteCursOnDate"`
+}
+
+type GetSeldCursOnDateXMLResultElem struct {
+	Vname   string `xml:"Vname" json:"Vname"`
+	Vnom    int32  `xml:"Vnom" json:"Vnom"`
+	Vcurs   string `xml:"Vcurs" json:"Vcurs"`
+	Vcode   string `xml:"Vcode" json:"Vcode"`
+	VchCode string `xml:"VchCode" json:"VchCode"`
+}
+
+type KeyRateXMLResult struct {
+	// KeyRate node
+	KR []KeyRateXMLResultElem `xml:"KR" json:"KR"`
+}
+
+type KeyRateXMLResultElem struct {
+	DT   time.Time `xml:"DT" json:"DT"`
+	Rate string    `xml:"Rate" json:"Rate"`
+}
+
+type MainInfoXMLResult struct {
+	// RegData node
+	KeyRate    KeyRateElem    `xml:"keyRate" json:"keyRate"`
+	Inflation  InflationElem  `xml:"Inflation" json:"Inflation"`
+	Stavka_ref Stavka_refElem `xml:"stavka_ref" json:"stavka_ref"`
+	GoldBaks   GoldBaksElem   `xml:"GoldBaks" json:"GoldBaks"`
+}
+
+type KeyRateElem struct {
+	Title   string `xml:"Title,attr" json:"Title"`
+	Date    string `xml:"Date,attr" json:"Date"`
+	KeyRate string `xml:",chardata" json:"keyRate"`
+}
+
+type InflationElem struct {
+	Title     string `xml:"Title,attr" json:"Title"`
+	Date      string `xml:"Date,attr" json:"Date"`
+	Inflation string `xml:",chardata" json:"Inflation"`
+}
+
+type Stavka_refElem struct {
+	Title      string `xml:"Title,attr" json:"Title"`
+	Date       string `xml:"Date,attr" json:"Date"`
+	Stavka_ref string `xml:",chardata" json:"stavka_ref"`
+}
+
+type GoldBaksElem struct {
+	Title    string `xml:"Title,attr" json:"Title"`
+	Date     string `xml:"Date,attr" json:"Date"`
+	GoldBaks string `xml:",chardata" json:"GoldBaks"`
+}
+
+type MKRXMLResult struct {
+	// mkr_base node
+	MKR []MKRXMLResultElem `xml:"MKR" json:"MKR"`
+}
+
+type MKRXMLResultElem struct {
+	CDate time.Time `xml:"CDate" json:"CDate"`
+	P1    string    `xml:"p1" json:"p1"`
+	D1    string    `xml:"d1" json:"d1"`
+	D7    string    `xml:"d7" json:"d7"`
+	D30   string    `xml:"d30" json:"d30"`
+	D90   string    `xml:"d90" json:"d90"`
+	D180  string    `xml:"d180" json:"d180"`
+	D360  string    `xml:"d360" json:"d360"`
+}
+
+type Mrrf7DXMLResult struct {
+	// mmrf7d node
+	Mr []Mrrf7DXMLResultElem `xml:"mr" json:"mr"`
+}
+
+type Mrrf7DXMLResultElem struct {
+	D0  time.Time `xml:"D0" json:"D0"`
+	Val string    `xml:"val" json:"val"`
+}
+
+type MrrfXMLResult struct {
+	// mmrf node
+	Mr []MrrfXMLResultElem `xml:"mr" json:"mr"`
+}
+
+type MrrfXMLResultElem struct {
+	D0 time.Time `xml:"D0" json:"D0"`
+	P1 string    `xml:"p1" json:"p1"`
+	P2 string    `xml:"p2" json:"p2"`
+	P3 string    `xml:"p3" json:"p3"`
+	P4 string    `xml:"p4" json:"p4"`
+	P5 string    `xml:"p5" json:"p5"`
+	P6 string    `xml:"p6" json:"p6"`
+}
+
+type NewsInfoXMLResult struct {
+	// NewsInfo node
+	News []NewsInfoXMLResultElem `xml:"News" json:"News"`
+}
+
+type NewsInfoXMLResultElem struct {
+	Doc_id  int64     `xml:"Doc_id" json:"Doc_id"`
+	DocDate time.Time `xml:"DocDate" json:"DocDate"`
+	Title   string    `xml:"Title" json:"Title"`
+	Url     string    `xml:"Url" json:"Url"`
+}
+
+type OmodInfoXMLResult struct {
+	// OMO node
+	Date            string         `xml:"Date,attr" json:"Date"`
+	DirectRepo      DirectRepoElem `xml:"DirectRepo" json:"DirectRepo"`
+	RevRepo         RevRepoElem    `xml:"RevRepo" json:"RevRepo"`
+	OBR             OBRElem        `xml:"OBR" json:"OBR"`
+	Deposit         string         `xml:"Deposit" json:"Deposit"`
+	Credit          string         `xml:"Credit" json:"Credit"`
+	VolNom          string         `xml:"VolNom" json:"VolNom"`
+	TotalFixRepoVol string         `xml:"TotalFixRepoVol" json:"TotalFixRepoVol"`
+	FixRepoDate     string         `xml:"FixRepoDate" json:"FixRepoDate"`
+	FixRepo1D       FixRepo1DElem  `xml:"FixRepo1D" json:"FixRepo1D"`
+	FixRepo7D       FixRepo7DElem  `xml:"FixRepo7D" json:"FixRepo7D"`
+	FixRepo1Y       FixRepo1YElem  `xml:"FixRepo1Y" json:"FixRepo1Y"`
+}
+
+type DirectRepoElem struct {
+	Time      string `xml:"Time,attr" json:"Time"`
+	Debt      string `xml:"debt" json:"debt"`
+	Rate      string `xml:"rate" json:"rate"`
+	Minrate1D string `xml:"minrate1D" json:"minrate1D"`
+	Minrate7D string `xml:"minrate7D" json:"minrate7D"`
+}
+
+type RevRepoElem struct {
+	Time     string `xml:"Time,attr" json:"Time"`
+	Debt     string `xml:"debt" json:"debt"`
+	Rate     string `xml:"rate" json:"rate"`
+	Sum_debt string `xml:"sum_debt" json:"sum_debt"`
+}
+
+type OBRElem struct {
+	Time string `xml:"Time,attr" json:"Time"`
+	Debt string `xml:"debt" json:"debt"`
+	Rate string `xml:"rate" json:"rate"`
+}
+
+type FixRepo1DElem struct {
+	Debt string `xml:"debt" json:"debt"`
+	Rate string `xml:"rate" json:"rate"`
+}
+
+type FixRepo7DElem struct {
+	Debt string `xml:"debt" json:"debt"`
+	Rate string `xml:"rate" json:"rate"`
+}
+
+type FixRepo1YElem struct {
+	Rate string `xml:"rate" json:"rate"`
+}
+
+type OstatDepoNewXMLResult struct {
+	// OD node
+	Odn []OstatDepoNewXMLResultElem `xml:"odn" json:"odn"`
+}
+
+type OstatDepoNewXMLResultElem struct {
+	DT     time.Time `xml:"DT" json:"DT"`
+	TOTAL  string    `xml:"TOTAL" json:"TOTAL"`
+	AUC_1W string    `xml:"AUC_1W" json:"AUC_1W"`
+	OV_P   string    `xml:"OV_P" json:"OV_P"`
+}
+
+type OstatDepoXMLResult struct {
+	// OD node
+	Odr []OstatDepoXMLResultElem `xml:"odr" json:"odr"`
+}
+
+type OstatDepoXMLResultElem struct {
+	D0    time.Time `xml:"D0" json:"D0"`
+	D1_7  string    `xml:"D1_7" json:"D1_7"`
+	D8_30 string    `xml:"D8_30" json:"D8_30"`
+	Total string    `xml:"total" json:"total"`
+}
+
+type OstatDynamicXMLResult struct {
+	// OstatDynamic node
+	Ostat []OstatDynamicXMLResultElem `xml:"Ostat" json:"Ostat"`
+}
+
+type OstatDynamicXMLResultElem struct {
+	DateOst  time.Time `xml:"DateOst" json:"DateOst"`
+	InRuss   string    `xml:"InRuss" json:"InRuss"`
+	InMoscow string    `xml:"InMoscow" json:"InMoscow"`
+}
+
+type OvernightXMLResult struct {
+	// Overnight node
+	OB []OvernightXMLResultElem `xml:"OB" json:"OB"`
+}
+
+type OvernightXMLResultElem struct {
+	Date   time.Time `xml:"date" json:"date"`
+	Stavka string    `xml:"stavka" json:"stavka"`
+}
+
+type Repo_debtXMLResult struct {
+	// Repo_debt node
+	RD []Repo_debtXMLResultElem `xml:"RD" json:"RD"`
+}
+
+type Repo_debtXMLResultElem struct {
+	Date     time.Time `xml:"Date" json:"Date"`
+	Debt     string    `xml:"debt" json:"debt"`
+	Debt_auc string    `xml:"debt_auc" json:"debt_auc"`
+	Debt_fix string    `xml:"debt_fix" json:"debt_fix"`
+}
+
+type RepoDebtUSDXMLResult struct {
+	// RepoDebtUSD node
+	Rd []RepoDebtUSDXMLResultElem `xml:"rd" json:"rd"`
+}
+
+type RepoDebtUSDXMLResultElem struct {
+	D0 time.Time `xml:"D0" json:"D0"`
+	TP int       `xml:"TP" json:"TP"`
+}
+
+type ROISfixXMLResult struct {
+	// ROISfix node
+	Rf []ROISfixXMLResultElem `xml:"rf" json:"rf"`
+}
+
+type ROISfixXMLResultElem struct {
+	D0  time.Time `xml:"D0" json:"D0"`
+	R1W string    `xml:"R1W" json:"R1W"`
+	R2W string    `xml:"R2W" json:"R2W"`
+	R1M string    `xml:"R1M" json:"R1M"`
+	R2M string    `xml:"R2M" json:"R2M"`
+	R3M string    `xml:"R3M" json:"R3M"`
+	R6M string    `xml:"R6M" json:"R6M"`
+}
+
+type RuoniaSVXMLResult struct {
+	// RuoniaSV node
+	Ra []RuoniaSVXMLResultElem `xml:"ra" json:"ra"`
+}
+
+type RuoniaSVXMLResultElem struct {
+	DT            time.Time `xml:"DT" json:"DT"`
+	RUONIA_Index  string    `xml:"RUONIA_Index" json:"RUONIA_Index"`
+	RUONIA_AVG_1M string    `xml:"RUONIA_AVG_1M" json:"RUONIA_AVG_1M"`
+	RUONIA_AVG_3M string    `xml:"RUONIA_AVG_3M" json:"RUONIA_AVG_3M"`
+	RUONIA_AVG_6M string    `xml:"RUONIA_AVG_6M" json:"RUONIA_AVG_6M"`
+}
+
+type RuoniaXMLResult struct {
+	// Ruonia node
+	Ro []RuoniaXMLResultElem `xml:"ro" json:"ro"`
+}
+
+type RuoniaXMLResultElem struct {
+	D0         time.Time `xml:"D0" json:"D0"`
+	Ruo        string    `xml:"ruo" json:"ruo"`
+	Vol        string    `xml:"vol" json:"vol"`
+	DateUpdate time.Time `xml:"DateUpdate" json:"DateUpdate"`
+}
+
+type SaldoXMLResult struct {
+	// Saldo node
+	So []SaldoXMLResultElem `xml:"So" json:"So"`
+}
+
+type SaldoXMLResultElem struct {
+	Dt         time.Time `xml:"Dt" json:"Dt"`
+	DEADLINEBS string    `xml:"DEADLINEBS" json:"DEADLINEBS"`
+}
+
+type SwapDayTotalXMLResult struct {
+	// SwapDayTotal node
+	SDT []SwapDayTotalXMLResultElem `xml:"SDT" json:"SDT"`
+}
+
+type SwapDayTotalXMLResultElem struct {
+	DT   time.Time `xml:"DT" json:"DT"`
+	Swap string    `xml:"Swap" json:"Swap"`
+}
+
+type SwapDynamicXMLResult struct {
+	// SwapDynamic node
+	Swap []SwapDynamicXMLResultElem `xml:"Swap" json:"Swap"`
+}
+
+type SwapDynamicXMLResultElem struct {
+	DateBuy  time.Time `xml:"DateBuy" json:"DateBuy"`
+	DateSell time.Time `xml:"DateSell" json:"DateSell"`
+	BaseRate string    `xml:"BaseRate" json:"BaseRate"`
+	SD       string    `xml:"SD" json:"SD"`
+	TIR      string    `xml:"TIR" json:"TIR"`
+	Stavka   string    `xml:"Stavka" json:"Stavka"`
+	Currency int       `xml:"Currency" json:"Currency"`
+}
+
+type SwapInfoSellUSDVolXMLResult struct {
+	// SwapInfoSellUSDVol node
+	SSUV []SwapInfoSellUSDVolXMLResultElem `xml:"SSUV" json:"SSUV"`
+}
+
+type SwapInfoSellUSDVolXMLResultElem struct {
+	DT           time.Time `xml:"DT" json:"DT"`
+	TODTOMrubvol string    `xml:"TODTOMrubvol" json:"TODTOMrubvol"`
+	TODTOMusdvol string    `xml:"TODTOMusdvol" json:"TODTOMusdvol"`
+	TOMSPTrubvol string    `xml:"TOMSPTrubvol" json:"TOMSPTrubvol"`
+	TOMSPTusdvol string    `xml:"TOMSPTusdvol" json:"TOMSPTusdvol"`
+}
+
+type SwapInfoSellUSDXMLResult struct {
+	// swapinfosellusd node
+	SSU []SwapInfoSellUSDXMLResultElem `xml:"SSU" json:"SSU"`
+}
+
+type SwapInfoSellUSDXMLResultElem struct {
+	DateBuy  time.Time `xml:"DateBuy" json:"DateBuy"`
+	DateSell time.Time `xml:"DateSell" json:"DateSell"`
+	DateSPOT time.Time `xml:"DateSPOT" json:"DateSPOT"`
+	Type     int       `xml:"Type" json:"Type"`
+	BaseRate string    `xml:"BaseRate" json:"BaseRate"`
+	SD       string    `xml:"SD" json:"SD"`
+	TIR      string    `xml:"TIR" json:"TIR"`
+	Stavka   string    `xml:"Stavka" json:"Stavka"`
+	Limit    string    `xml:"limit" json:"limit"`
+}
+
+type SwapInfoSellVolXMLResult struct {
+	// SwapInfoSellVol node
+	SSUV []SwapInfoSellVolXMLResultElem `xml:"SSUV" json:"SSUV"`
+}
+
+type SwapInfoSellVolXMLResultElem struct {
+	DT       time.Time `xml:"DT" json:"DT"`
+	Currency int       `xml:"Currency" json:"Currency"`
+	Type     int       `xml:"type" json:"type"`
+	VOL_FC   string    `xml:"VOL_FC" json:"VOL_FC"`
+	VOL_RUB  string    `xml:"VOL_RUB" json:"VOL_RUB"`
+}
+
+type SwapInfoSellXMLResult struct {
+	// SwapInfoSell node
+	SSU []SwapInfoSellXMLResultElem `xml:"SSU" json:"SSU"`
+}
+
+type SwapInfoSellXMLResultElem struct {
+	Currency int       `xml:"Currency" json:"Currency"`
+	DateBuy  time.Time `xml:"DateBuy" json:"DateBuy"`
+	DateSell time.Time `xml:"DateSell" json:"DateSell"`
+	DateSPOT time.Time `xml:"DateSPOT" json:"DateSPOT"`
+	Type     int       `xml:"Type" json:"Type"`
+	BaseRate string    `xml:"BaseRate" json:"BaseRate"`
+	SD       string    `xml:"SD" json:"SD"`
+	TIR      string    `xml:"TIR" json:"TIR"`
+	Stavka   string    `xml:"Stavka" json:"Stavka"`
+	Limit    string    `xml:"limit" json:"limit"`
+}
+
+type SwapMonthTotalXMLResult struct {
+	// SwapMonthTotal node
+	SMT []SwapMonthTotalXMLResultElem `xml:"SMT" json:"SMT"`
+}
+
+type SwapMonthTotalXMLResultElem struct {
+	D0  time.Time `xml:"D0" json:"D0"`
+	RUB string    `xml:"RUB" json:"RUB"`
+	USD string    `xml:"USD" json:"USD"`
+}
@@ -0,0 +1,312 @@
+package diskcache
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+)
+
+// LogFileName is the name of the log of cache changes in the cache directory.
+const LogFileName = "cache.log"
+
+// Operations of the log records.
+const (
+	opSet    = "set"
+	opRemove = "del"
+)
+
+type Logger interface {
+	Info(msg string)
+	Warning(msg string)
+	Error(msg string)
+	Fatal(msg string)
+	GetZapLogger() *zap.SugaredLogger
+}
+
+// record is one line of the log, payload is JSON of the value of the registered type.
+type record struct {
+	Op  string `json:"op"`
+	Tag string `json:"tag"`
+	memcache.StoredCacheInfo
+}
+
+// DiskCache is AppMemCache, which keeps data in MemCache and writes every change to the append-only log in the cache directory.
+// Every record is synced to disk, eviction from MemCache under its memory budget is written as removal.
+// The log is loaded on Init and is compacted to the current data on Init and on removal of expired data,
+// so cached data survives restarts. File errors are logged, the cache keeps working in memory.
+type DiskCache struct {
+	mu     sync.Mutex
+	logger Logger
+	dir    string
+	memory *memcache.MemCache
+	types  memcache.PayloadTypes
+	file   *os.File
+}
+
+// New creates DiskCache in the directory, payloads of other types than payloadTypes are cached only in memory.
+func New(logger Logger, dir string, memory *memcache.MemCache, payloadTypes []reflect.Type) *DiskCache {
+	dc := &DiskCache{
+		logger: logger,
+		dir:    dir,
+		memory: memory,
+		types:  memcache.NewPayloadTypes(payloadTypes),
+	}
+	memory.SetEvictionHandler(dc.evicted)
+	return dc
+}
+
+// evicted writes removal of the entry evicted from memory, MemCache evicts only on adding of data under the mutex.
+func (dc *DiskCache) evicted(tag string) {
+	dc.appendRecord(record{Op: opRemove, Tag: tag})
+}
+
+func (dc *DiskCache) Init() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.memory.Init()
+	dc.closeFile()
+	err := os.MkdirAll(dc.dir, 0o750)
+	if err != nil {
+		dc.logger.Error("disk cache: " + err.Error())
+		return
+	}
+	loaded, err := dc.load()
+	if err != nil {
+		dc.logger.Error("disk cache: load: " + err.Error())
+	}
+	dc.logger.Info(fmt.Sprintf("disk cache: %d records loaded from %s", loaded, dc.logPath()))
+	dc.compact()
+}
+
+func (dc *DiskCache) logPath() string {
+	return filepath.Join(dc.dir, LogFileName)
+}
+
+// load replays the log into memory, broken records (for example, the last record written on crash) are skipped.
+func (dc *DiskCache) load() (int, error) {
+	file, err := os.Open(dc.logPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	loaded := 0
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			if errRecord := dc.applyRecord(line); errRecord != nil {
+				dc.logger.Warning("disk cache: skip record: " + errRecord.Error())
+			} else {
+				loaded++
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return loaded, nil
+		}
+		if err != nil {
+			return loaded, err
+		}
+	}
+}
+
+func (dc *DiskCache) applyRecord(line []byte) error {
+	var rec record
+	err := json.Unmarshal(line, &rec)
+	if err != nil {
+		return err
+	}
+	switch rec.Op {
+	case opRemove:
+		dc.memory.RemovePayloadInCache(rec.Tag)
+		return nil
+	case opSet:
+		info, err := dc.types.Decode(rec.StoredCacheInfo)
+		if err != nil {
+			return fmt.Errorf("%s: %w", rec.Tag, err)
+		}
+		dc.memory.RestoreCacheDataInCache(rec.Tag, info)
+		return nil
+	default:
+		return fmt.Errorf("unknown operation %q", rec.Op)
+	}
+}
+
+func (dc *DiskCache) setRecord(tag string, info memcache.CacheInfo) (record, error) { //nolint: gocritic
+	stored, err := dc.types.Encode(info)
+	if err != nil {
+		return record{}, err
+	}
+	return record{Op: opSet, Tag: tag, StoredCacheInfo: stored}, nil
+}
+
+// compact rewrites the log by data in memory and opens it for appending.
+func (dc *DiskCache) compact() {
+	dc.closeFile()
+	tmpFile, err := os.CreateTemp(dc.dir, LogFileName+".*.tmp")
+	if err != nil {
+		dc.logger.Error("disk cache: compact: " + err.Error())
+		return
+	}
+	err = dc.writeSnapshot(tmpFile)
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), dc.logPath())
+	}
+	if err != nil {
+		dc.logger.Error("disk cache: compact: " + err.Error())
+		os.Remove(tmpFile.Name())
+	}
+	dc.file, err = os.OpenFile(dc.logPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		dc.logger.Error("disk cache: " + err.Error())
+		dc.file = nil
+	}
+}
+
+func (dc *DiskCache) writeSnapshot(file *os.File) error {
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for tag, info := range dc.memory.Snapshot() {
+		rec, err := dc.setRecord(tag, info)
+		if err != nil {
+			continue
+		}
+		err = encoder.Encode(rec)
+		if err != nil {
+			file.Close()
+			return err
+		}
+	}
+	err := writer.Flush()
+	if err == nil {
+		err = file.Sync()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	return errClose
+}
+
+func (dc *DiskCache) appendRecord(rec record) { //nolint: gocritic
+	if dc.file == nil {
+		return
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		dc.logger.Error("disk cache: " + err.Error())
+		return
+	}
+	_, err = dc.file.Write(append(line, '\n'))
+	if err == nil {
+		err = dc.file.Sync()
+	}
+	if err != nil {
+		dc.logger.Error("disk cache: " + err.Error())
+	}
+}
+
+func (dc *DiskCache) closeFile() {
+	if dc.file == nil {
+		return
+	}
+	err := dc.file.Close()
+	if err != nil {
+		dc.logger.Error("disk cache: " + err.Error())
+	}
+	dc.file = nil
+}
+
+// Close closes the log, the cache works only in memory after it.
+func (dc *DiskCache) Close() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.closeFile()
+}
+
+func (dc *DiskCache) AddOrUpdatePayloadInCache(tag string, payload interface{}) bool {
+	return dc.addOrUpdatePayload(tag, memcache.CacheInfo{Payload: payload, InfoDTStamp: time.Now()})
+}
+
+func (dc *DiskCache) AddOrUpdateHistoricalPayloadInCache(tag string, payload interface{}) bool {
+	return dc.addOrUpdatePayload(tag, memcache.CacheInfo{Payload: payload, InfoDTStamp: time.Now(), Historical: true})
+}
+
+// RestoreCacheDataInCache adds or updates cached data with its time stamp, for example, imported from the snapshot.
+func (dc *DiskCache) RestoreCacheDataInCache(tag string, info memcache.CacheInfo) bool { //nolint: gocritic
+	return dc.addOrUpdatePayload(tag, info)
+}
+
+func (dc *DiskCache) addOrUpdatePayload(tag string, info memcache.CacheInfo) bool { //nolint: gocritic
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	ok := dc.memory.RestoreCacheDataInCache(tag, info)
+	if _, kept := dc.memory.PeekCacheDataInCache(tag); !kept {
+		// too large data is not cached, its removal is written on eviction
+		return ok
+	}
+	rec, err := dc.setRecord(tag, info)
+	if err != nil {
+		dc.logger.Warning("disk cache: " + tag + " is cached only in memory: " + err.Error())
+		return ok
+	}
+	dc.appendRecord(rec)
+	// true is update
+	return ok
+}
+
+func (dc *DiskCache) RemovePayloadInCache(tag string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.memory.RemovePayloadInCache(tag)
+	dc.appendRecord(record{Op: opRemove, Tag: tag})
+}
+
+func (dc *DiskCache) RemoveAllPayloadInCacheByTimeStamp(controlTime time.Time) int {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	removed := dc.memory.RemoveAllPayloadInCacheByTimeStamp(controlTime)
+	dc.compact()
+	return removed
+}
+
+func (dc *DiskCache) RemoveHistoricalPayloadInCacheByTimeStamp(controlTime time.Time) int {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	removed := dc.memory.RemoveHistoricalPayloadInCacheByTimeStamp(controlTime)
+	dc.compact()
+	return removed
+}
+
+func (dc *DiskCache) GetCacheDataInCache(tag string) (memcache.CacheInfo, bool) {
+	return dc.memory.GetCacheDataInCache(tag)
+}
+
+func (dc *DiskCache) PeekCacheDataInCache(tag string) (memcache.CacheInfo, bool) {
+	return dc.memory.PeekCacheDataInCache(tag)
+}
+
+func (dc *DiskCache) Snapshot() map[string]memcache.CacheInfo {
+	return dc.memory.Snapshot()
+}
+
+func (dc *DiskCache) GetAllCacheEntries() []memcache.EntryInfo {
+	return dc.memory.GetAllCacheEntries()
+}
+
+func (dc *DiskCache) PrintAllCacheKeys() {
+	dc.memory.PrintAllCacheKeys()
+}
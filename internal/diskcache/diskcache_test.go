@@ -0,0 +1,146 @@
+package diskcache_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+	diskcache "github.com/skolzkyi/cbrwsdltojson/internal/diskcache"
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+	mocks "github.com/skolzkyi/cbrwsdltojson/internal/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+var payloadTypes = []reflect.Type{
+	reflect.TypeOf(datastructures.KeyRateXMLResult{}),
+	reflect.TypeOf(datastructures.GetCursOnDateXMLResult{}),
+}
+
+func openDiskCache(t *testing.T, dir string) *diskcache.DiskCache {
+	t.Helper()
+	return openDiskCacheWithMemory(t, dir, memcache.New())
+}
+
+func openDiskCacheWithMemory(t *testing.T, dir string, memory *memcache.MemCache) *diskcache.DiskCache {
+	t.Helper()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	diskCache := diskcache.New(loggerMock, dir, memory, payloadTypes)
+	diskCache.Init()
+	t.Cleanup(diskCache.Close)
+	return diskCache
+}
+
+func logLines(t *testing.T, dir string) int {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, diskcache.LogFileName))
+	require.NoError(t, err)
+	return bytes.Count(data, []byte("\n"))
+}
+
+func TestDiskCache(t *testing.T) {
+	t.Parallel()
+	keyRate := datastructures.KeyRateXMLResult{KR: []datastructures.KeyRateXMLResultElem{
+		{DT: time.Date(2023, 6, 22, 0, 0, 0, 0, time.FixedZone("", 3*60*60)), Rate: "7.50"},
+	}}
+	cursOnDate := datastructures.GetCursOnDateXMLResult{OnDate: "20230622", ValuteCursOnDate: []datastructures.GetCursOnDateXMLResultElem{
+		{Vname: "Австралийский доллар", Vnom: 1, Vcurs: "57.1445", Vcode: "36", VchCode: "AUD"},
+	}}
+
+	t.Run("TestDiskCache: SurvivesRestart", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		diskCache := openDiskCache(t, dir)
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML", keyRate)
+		diskCache.AddOrUpdateHistoricalPayloadInCache("GetCursOnDateXML", cursOnDate)
+		diskCache.AddOrUpdatePayloadInCache("removed", keyRate)
+		diskCache.RemovePayloadInCache("removed")
+		// payload of not registered type is cached only in memory
+		diskCache.AddOrUpdatePayloadInCache("int", 1)
+		_, ok := diskCache.GetCacheDataInCache("int")
+		require.Equal(t, true, ok)
+		keyRateInfo, ok := diskCache.GetCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+		diskCache.Close()
+
+		restarted := openDiskCache(t, dir)
+		restoredInfo, ok := restarted.GetCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+		require.Equal(t, false, restoredInfo.Historical)
+		require.True(t, keyRateInfo.InfoDTStamp.Equal(restoredInfo.InfoDTStamp))
+		restoredKeyRate, ok := restoredInfo.Payload.(datastructures.KeyRateXMLResult)
+		require.Equal(t, true, ok)
+		require.True(t, keyRate.KR[0].DT.Equal(restoredKeyRate.KR[0].DT))
+		require.Equal(t, keyRate.KR[0].Rate, restoredKeyRate.KR[0].Rate)
+		restoredInfo, ok = restarted.GetCacheDataInCache("GetCursOnDateXML")
+		require.Equal(t, true, ok)
+		require.Equal(t, true, restoredInfo.Historical)
+		require.Equal(t, cursOnDate, restoredInfo.Payload)
+		for _, tag := range []string{"removed", "int"} {
+			_, ok = restarted.GetCacheDataInCache(tag)
+			require.Equal(t, false, ok, tag)
+		}
+		// the log is compacted on start
+		require.Equal(t, 2, logLines(t, dir))
+	})
+	t.Run("TestDiskCache: BrokenRecord", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		diskCache := openDiskCache(t, dir)
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML", keyRate)
+		diskCache.Close()
+		file, err := os.OpenFile(filepath.Join(dir, diskcache.LogFileName), os.O_APPEND|os.O_WRONLY, 0o640)
+		require.NoError(t, err)
+		_, err = file.WriteString(`{"op":"set","tag":"GetCursOnDateXML","type":"datastructures.GetCursOnDateXMLResult","payload":{"OnDa`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		restarted := openDiskCache(t, dir)
+		_, ok := restarted.GetCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+		_, ok = restarted.GetCacheDataInCache("GetCursOnDateXML")
+		require.Equal(t, false, ok)
+	})
+	t.Run("TestDiskCache: RemoveByTimeStamp", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		diskCache := openDiskCache(t, dir)
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML", keyRate)
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML", keyRate)
+		diskCache.AddOrUpdateHistoricalPayloadInCache("GetCursOnDateXML", cursOnDate)
+		require.Equal(t, 3, logLines(t, dir))
+		time.Sleep(time.Millisecond)
+		diskCache.RemoveAllPayloadInCacheByTimeStamp(time.Now())
+		require.Equal(t, 1, logLines(t, dir))
+		diskCache.RemoveHistoricalPayloadInCacheByTimeStamp(time.Now())
+		require.Equal(t, 0, logLines(t, dir))
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML", keyRate)
+		require.Equal(t, 1, logLines(t, dir))
+	})
+	t.Run("TestDiskCache: Eviction", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		// memory budget keeps only one KeyRateXML entry
+		memory := memcache.NewWithMemoryBudget(memcache.EntrySize("KeyRateXML1", keyRate), memcache.Metrics{})
+		diskCache := openDiskCacheWithMemory(t, dir, memory)
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML1", keyRate)
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML2", keyRate)
+		// too large data is not written
+		largeKeyRate := datastructures.KeyRateXMLResult{KR: append(append([]datastructures.KeyRateXMLResultElem(nil), keyRate.KR...), keyRate.KR...)}
+		diskCache.AddOrUpdatePayloadInCache("KeyRateXML3", largeKeyRate)
+		require.Equal(t, 4, logLines(t, dir))
+		diskCache.Close()
+
+		restarted := openDiskCache(t, dir)
+		_, ok := restarted.GetCacheDataInCache("KeyRateXML2")
+		require.Equal(t, true, ok)
+		for _, tag := range []string{"KeyRateXML1", "KeyRateXML3"} {
+			_, ok = restarted.GetCacheDataInCache(tag)
+			require.Equal(t, false, ok, tag)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package memcache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var ErrUnknownPayloadType = errors.New("unknown type of cached payload")
+
+// StoredCacheInfo is CacheInfo for external storage: payload is JSON, Type is the name of the payload type.
+type StoredCacheInfo struct {
+	Type        string          `json:"type,omitempty"`
+	InfoDTStamp time.Time       `json:"infoDTStamp,omitempty"`
+	Historical  bool            `json:"historical,omitempty"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+}
+
+// PayloadTypes are types of payloads, which can be stored externally, by their names.
+type PayloadTypes map[string]reflect.Type
+
+func NewPayloadTypes(types []reflect.Type) PayloadTypes {
+	payloadTypes := make(PayloadTypes, len(types))
+	for _, payloadType := range types {
+		payloadTypes[payloadType.String()] = payloadType
+	}
+	return payloadTypes
+}
+
+func (pt PayloadTypes) Encode(info CacheInfo) (StoredCacheInfo, error) { //nolint: gocritic
+	payloadType := reflect.TypeOf(info.Payload)
+	if payloadType == nil || pt[payloadType.String()] != payloadType {
+		return StoredCacheInfo{}, fmt.Errorf("%w: %v", ErrUnknownPayloadType, payloadType)
+	}
+	payload, err := json.Marshal(info.Payload)
+	if err != nil {
+		return StoredCacheInfo{}, err
+	}
+	return StoredCacheInfo{
+		Type:        payloadType.String(),
+		InfoDTStamp: info.InfoDTStamp,
+		Historical:  info.Historical,
+		Payload:     payload,
+	}, nil
+}
+
+func (pt PayloadTypes) Decode(stored StoredCacheInfo) (CacheInfo, error) { //nolint: gocritic
+	payloadType, ok := pt[stored.Type]
+	if !ok {
+		return CacheInfo{}, fmt.Errorf("%w: %s", ErrUnknownPayloadType, stored.Type)
+	}
+	payload := reflect.New(payloadType)
+	err := json.Unmarshal(stored.Payload, payload.Interface())
+	if err != nil {
+		return CacheInfo{}, err
+	}
+	return CacheInfo{
+		Payload:     payload.Elem().Interface(),
+		InfoDTStamp: stored.InfoDTStamp,
+		Historical:  stored.Historical,
+	}, nil
+}
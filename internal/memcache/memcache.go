@@ -1,72 +1,279 @@
 package memcache
 
 import (
+	"container/list"
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reasons of eviction.
+const (
+	EvictionCapacity = "capacity"
+	EvictionTooLarge = "too_large"
 )
 
 type CacheInfo struct {
 	Payload     interface{}
 	InfoDTStamp time.Time
+	// Historical data is of the closed past date range, it is not changed any more and is kept longer
+	Historical bool
+}
+
+// EntryInfo describes cached data without its payload.
+type EntryInfo struct {
+	Tag         string
+	InfoDTStamp time.Time
+	Historical  bool
+	// Size is the estimated size of the entry in bytes
+	Size int64
+}
+
+type cacheEntry struct {
+	tag  string
+	info CacheInfo
+	size int64
+}
+
+type Metrics struct {
+	Evictions *prometheus.CounterVec
+	SizeBytes prometheus.Gauge
+	Entries   prometheus.Gauge
 }
 
+func CreateMetrics() Metrics {
+	return Metrics{
+		Evictions: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "memcache",
+			Name:      "evictions_total",
+		}, []string{"reason"}),
+		SizeBytes: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "memcache",
+			Name:      "size_bytes",
+			Help:      "estimated size of cached data",
+		}),
+		Entries: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "memcache",
+			Name:      "entries",
+		}),
+	}
+}
+
+// MemCache keeps the estimated size of cached data within the memory budget by eviction of least recently used entries.
 type MemCache struct {
-	mu    sync.RWMutex
-	cache map[string]CacheInfo
+	mu        sync.Mutex
+	cache     map[string]*list.Element
+	lru       *list.List
+	size      int64
+	maxMemory int64
+	metrics   Metrics
+	// onEvict is called with the tag of the entry evicted under the memory budget, may be nil.
+	onEvict func(tag string)
 }
 
+// New creates MemCache without memory budget.
 func New() *MemCache {
 	return &MemCache{}
 }
 
+// NewWithMemoryBudget creates MemCache, which estimated size does not exceed maxMemory bytes, zero is no budget.
+func NewWithMemoryBudget(maxMemory int64, metrics Metrics) *MemCache {
+	return &MemCache{maxMemory: maxMemory, metrics: metrics}
+}
+
+// SetEvictionHandler sets the function, which is called with the tag of every entry evicted under the memory budget.
+// It is called under the lock of MemCache and must not call MemCache.
+func (mc *MemCache) SetEvictionHandler(onEvict func(tag string)) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.onEvict = onEvict
+}
+
 func (mc *MemCache) Init() {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
-	mc.cache = make(map[string]CacheInfo)
+	mc.cache = make(map[string]*list.Element)
+	mc.lru = list.New()
+	mc.size = 0
+	mc.observeSize()
 }
 
 func (mc *MemCache) AddOrUpdatePayloadInCache(tag string, payload interface{}) bool {
+	return mc.addOrUpdatePayload(tag, payload, false)
+}
+
+func (mc *MemCache) AddOrUpdateHistoricalPayloadInCache(tag string, payload interface{}) bool {
+	return mc.addOrUpdatePayload(tag, payload, true)
+}
+
+func (mc *MemCache) addOrUpdatePayload(tag string, payload interface{}, historical bool) bool {
+	return mc.RestoreCacheDataInCache(tag, CacheInfo{
+		Payload:     payload,
+		InfoDTStamp: time.Now(),
+		Historical:  historical,
+	})
+}
+
+// RestoreCacheDataInCache adds or updates cached data with its time stamp, for example, loaded from persistent storage.
+func (mc *MemCache) RestoreCacheDataInCache(tag string, info CacheInfo) bool {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
-	tempEl, ok := mc.cache[tag]
-	tempEl.Payload = payload
-	tempEl.InfoDTStamp = time.Now()
-	mc.cache[tag] = tempEl
+	defer mc.observeSize()
+	element, ok := mc.cache[tag]
+	if ok {
+		mc.removeElement(element)
+	}
+	entry := &cacheEntry{
+		tag:  tag,
+		info: info,
+		size: EntrySize(tag, info.Payload),
+	}
+	if mc.maxMemory > 0 && entry.size > mc.maxMemory {
+		mc.evicted(tag, EvictionTooLarge)
+		// true is update
+		return ok
+	}
+	mc.cache[tag] = mc.lru.PushFront(entry)
+	mc.size += entry.size
+	for mc.maxMemory > 0 && mc.size > mc.maxMemory {
+		evicted := mc.lru.Back().Value.(*cacheEntry).tag
+		mc.removeElement(mc.lru.Back())
+		mc.evicted(evicted, EvictionCapacity)
+	}
 	// true is update
 	return ok
 }
 
+func (mc *MemCache) removeElement(element *list.Element) {
+	entry := mc.lru.Remove(element).(*cacheEntry)
+	delete(mc.cache, entry.tag)
+	mc.size -= entry.size
+}
+
 func (mc *MemCache) RemovePayloadInCache(tag string) {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
-	delete(mc.cache, tag)
+	element, ok := mc.cache[tag]
+	if ok {
+		mc.removeElement(element)
+		mc.observeSize()
+	}
 }
 
+// GetCacheDataInCache returns cached data and marks it as recently used.
 func (mc *MemCache) GetCacheDataInCache(tag string) (CacheInfo, bool) {
-	mc.mu.RLock()
-	defer mc.mu.RUnlock()
-	_, ok := mc.cache[tag]
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	element, ok := mc.cache[tag]
 	if !ok {
 		return CacheInfo{}, ok
 	}
-	return mc.cache[tag], ok
+	mc.lru.MoveToFront(element)
+	return element.Value.(*cacheEntry).info, ok
 }
 
-func (mc *MemCache) RemoveAllPayloadInCacheByTimeStamp(controlTime time.Time) {
+// PeekCacheDataInCache returns cached data without change of its LRU order.
+func (mc *MemCache) PeekCacheDataInCache(tag string) (CacheInfo, bool) {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
-	for key, data := range mc.cache {
-		if data.InfoDTStamp.Before(controlTime) {
-			delete(mc.cache, key)
+	element, ok := mc.cache[tag]
+	if !ok {
+		return CacheInfo{}, ok
+	}
+	return element.Value.(*cacheEntry).info, ok
+}
+
+// RemoveAllPayloadInCacheByTimeStamp removes not historical data cached before the control time and returns the number of removed entries.
+func (mc *MemCache) RemoveAllPayloadInCacheByTimeStamp(controlTime time.Time) int {
+	return mc.removePayloadByTimeStamp(controlTime, false)
+}
+
+func (mc *MemCache) RemoveHistoricalPayloadInCacheByTimeStamp(controlTime time.Time) int {
+	return mc.removePayloadByTimeStamp(controlTime, true)
+}
+
+func (mc *MemCache) removePayloadByTimeStamp(controlTime time.Time, historical bool) int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	removed := 0
+	for _, element := range mc.cache {
+		entry := element.Value.(*cacheEntry)
+		if entry.info.Historical == historical && entry.info.InfoDTStamp.Before(controlTime) {
+			mc.removeElement(element)
+			removed++
 		}
 	}
+	mc.observeSize()
+	return removed
+}
+
+// Snapshot returns copy of all cached data by tags.
+func (mc *MemCache) Snapshot() map[string]CacheInfo {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	snapshot := make(map[string]CacheInfo, len(mc.cache))
+	for tag, element := range mc.cache {
+		snapshot[tag] = element.Value.(*cacheEntry).info
+	}
+	return snapshot
+}
+
+// GetAllCacheEntries returns descriptions of all cached data, entries are not marked as recently used.
+func (mc *MemCache) GetAllCacheEntries() []EntryInfo {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	entries := make([]EntryInfo, 0, len(mc.cache))
+	for _, element := range mc.cache {
+		entry := element.Value.(*cacheEntry)
+		entries = append(entries, EntryInfo{
+			Tag:         entry.tag,
+			InfoDTStamp: entry.info.InfoDTStamp,
+			Historical:  entry.info.Historical,
+			Size:        entry.size,
+		})
+	}
+	return entries
+}
+
+// Size returns the estimated size of cached data in bytes.
+func (mc *MemCache) Size() int64 {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	return mc.size
 }
 
 func (mc *MemCache) PrintAllCacheKeys() {
-	mc.mu.RLock()
-	defer mc.mu.RUnlock()
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
 	for key := range mc.cache {
 		fmt.Println(key)
 	}
 }
+
+func (mc *MemCache) observeSize() {
+	if mc.metrics.SizeBytes != nil {
+		mc.metrics.SizeBytes.Set(float64(mc.size))
+	}
+	if mc.metrics.Entries != nil {
+		mc.metrics.Entries.Set(float64(len(mc.cache)))
+	}
+}
+
+func (mc *MemCache) evicted(tag string, reason string) {
+	mc.observeEviction(reason)
+	if mc.onEvict != nil {
+		mc.onEvict(tag)
+	}
+}
+
+func (mc *MemCache) observeEviction(reason string) {
+	if mc.metrics.Evictions != nil {
+		mc.metrics.Evictions.WithLabelValues(reason).Inc()
+	}
+}
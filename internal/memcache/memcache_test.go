@@ -1,6 +1,7 @@
 package memcache_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -55,4 +56,81 @@ func TestAllMemCacheCases(t *testing.T) {
 		_, ok = memcacheExempl.GetCacheDataInCache("testTag_RemoveAllPayloadInCacheByTimeStamp_3")
 		require.Equal(t, true, ok)
 	})
+	t.Run("Test_RemoveHistoricalPayloadInCacheByTimeStamp", func(t *testing.T) {
+		memcacheExempl.AddOrUpdateHistoricalPayloadInCache("testTag_RemoveHistoricalPayloadInCacheByTimeStamp_1", "testPayload_RemoveHistoricalPayloadInCacheByTimeStamp_1")
+		testCacheData, ok := memcacheExempl.GetCacheDataInCache("testTag_RemoveHistoricalPayloadInCacheByTimeStamp_1")
+		require.Equal(t, true, ok)
+		require.Equal(t, true, testCacheData.Historical)
+		memcacheExempl.AddOrUpdatePayloadInCache("testTag_RemoveHistoricalPayloadInCacheByTimeStamp_2", "testPayload_RemoveHistoricalPayloadInCacheByTimeStamp_2")
+		time.Sleep(time.Millisecond)
+		testStartTime := time.Now()
+		memcacheExempl.RemoveAllPayloadInCacheByTimeStamp(testStartTime)
+		_, ok = memcacheExempl.GetCacheDataInCache("testTag_RemoveHistoricalPayloadInCacheByTimeStamp_1")
+		require.Equal(t, true, ok)
+		_, ok = memcacheExempl.GetCacheDataInCache("testTag_RemoveHistoricalPayloadInCacheByTimeStamp_2")
+		require.Equal(t, false, ok)
+		memcacheExempl.RemoveHistoricalPayloadInCacheByTimeStamp(testStartTime)
+		_, ok = memcacheExempl.GetCacheDataInCache("testTag_RemoveHistoricalPayloadInCacheByTimeStamp_1")
+		require.Equal(t, false, ok)
+	})
+}
+
+func TestMemoryBudget(t *testing.T) {
+	t.Parallel()
+	payload := strings.Repeat("p", 1000)
+	entrySize := memcache.EstimateSize("tag1") + memcache.EstimateSize(payload)
+	memcacheExempl := memcache.NewWithMemoryBudget(3*entrySize+3*256, memcache.Metrics{})
+	memcacheExempl.Init()
+	for _, tag := range []string{"tag1", "tag2", "tag3"} {
+		memcacheExempl.AddOrUpdatePayloadInCache(tag, payload)
+	}
+	// tag1 is used recently, so tag2 is evicted by tag4, peek of tag2 does not mark it as used
+	_, ok := memcacheExempl.GetCacheDataInCache("tag1")
+	require.Equal(t, true, ok)
+	_, ok = memcacheExempl.PeekCacheDataInCache("tag2")
+	require.Equal(t, true, ok)
+	memcacheExempl.AddOrUpdatePayloadInCache("tag4", payload)
+	for tag, cached := range map[string]bool{"tag1": true, "tag2": false, "tag3": true, "tag4": true} {
+		_, ok = memcacheExempl.GetCacheDataInCache(tag)
+		require.Equal(t, cached, ok, tag)
+	}
+	require.LessOrEqual(t, memcacheExempl.Size(), 3*entrySize+3*256)
+	var entriesSize int64
+	entries := memcacheExempl.GetAllCacheEntries()
+	for _, entry := range entries {
+		require.Equal(t, memcache.EntrySize(entry.Tag, payload), entry.Size)
+		entriesSize += entry.Size
+	}
+	require.Len(t, entries, 3)
+	require.Equal(t, memcacheExempl.Size(), entriesSize)
+
+	// data larger than the budget is not cached
+	ok = memcacheExempl.AddOrUpdatePayloadInCache("tag1", strings.Repeat("p", 10000))
+	require.Equal(t, true, ok)
+	_, ok = memcacheExempl.GetCacheDataInCache("tag1")
+	require.Equal(t, false, ok)
+
+	memcacheExempl.RemovePayloadInCache("tag3")
+	memcacheExempl.RemoveAllPayloadInCacheByTimeStamp(time.Now())
+	require.Equal(t, int64(0), memcacheExempl.Size())
+}
+
+func TestEstimateSize(t *testing.T) {
+	t.Parallel()
+	type elem struct {
+		Name  string
+		Value string
+	}
+	type result struct {
+		Elems []elem
+	}
+	small := result{Elems: []elem{{Name: "a", Value: "1"}}}
+	large := result{Elems: make([]elem, 100)}
+	for i := range large.Elems {
+		large.Elems[i] = elem{Name: strings.Repeat("a", 10), Value: strings.Repeat("1", 10)}
+	}
+	require.Equal(t, int64(0), memcache.EstimateSize(nil))
+	require.Equal(t, int64(len("test")+16), memcache.EstimateSize("test"))
+	require.Greater(t, memcache.EstimateSize(large), 100*memcache.EstimateSize(small)/2)
+	require.Greater(t, memcache.EstimateSize(&large), memcache.EstimateSize(large))
 }
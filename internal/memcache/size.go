@@ -0,0 +1,69 @@
+package memcache
+
+import (
+	"reflect"
+)
+
+// entryOverhead is the approximate memory of the map item and the list element of one cache entry.
+const entryOverhead = 128
+
+// EntrySize returns the estimated size of the cache entry with the tag and the payload.
+func EntrySize(tag string, payload interface{}) int64 {
+	return EstimateSize(tag) + EstimateSize(payload) + entryOverhead
+}
+
+// EstimateSize returns the approximate memory of the value in bytes: sizes of its types plus referenced
+// strings, slices, maps and pointers. Shared memory is counted every time it is referenced.
+func EstimateSize(value interface{}) int64 {
+	if value == nil {
+		return 0
+	}
+	v := reflect.ValueOf(value)
+	return int64(v.Type().Size()) + referencedSize(v)
+}
+
+// referencedSize is the memory referenced by the value, the value itself is counted by the size of its type.
+func referencedSize(v reflect.Value) int64 {
+	switch v.Kind() { //nolint: exhaustive
+	case reflect.String:
+		return int64(v.Len())
+	case reflect.Slice:
+		if v.IsNil() {
+			return 0
+		}
+		size := int64(v.Cap()) * int64(v.Type().Elem().Size())
+		for i := 0; i < v.Len(); i++ {
+			size += referencedSize(v.Index(i))
+		}
+		return size
+	case reflect.Array:
+		var size int64
+		for i := 0; i < v.Len(); i++ {
+			size += referencedSize(v.Index(i))
+		}
+		return size
+	case reflect.Struct:
+		var size int64
+		for i := 0; i < v.NumField(); i++ {
+			size += referencedSize(v.Field(i))
+		}
+		return size
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return int64(v.Elem().Type().Size()) + referencedSize(v.Elem())
+	case reflect.Map:
+		if v.IsNil() {
+			return 0
+		}
+		size := int64(v.Len()) * int64(v.Type().Key().Size()+v.Type().Elem().Size())
+		iter := v.MapRange()
+		for iter.Next() {
+			size += referencedSize(iter.Key()) + referencedSize(iter.Value())
+		}
+		return size
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,106 @@
+package memcache
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// SnapshotVersion is the version of the snapshot format, snapshots of other versions are not imported.
+const SnapshotVersion = 1
+
+var ErrSnapshotVersion = errors.New("unsupported version of cache snapshot")
+
+// gzipMagic is the beginning of gzip data, snapshot without it is plain JSON.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+type snapshotEntry struct {
+	Tag string `json:"tag"`
+	StoredCacheInfo
+}
+
+type snapshotFile struct {
+	Version   int             `json:"version"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Entries   []snapshotEntry `json:"entries"`
+}
+
+// Restorer is the cache, which keeps time stamps of restored data.
+type Restorer interface {
+	RestoreCacheDataInCache(tag string, info CacheInfo) bool
+}
+
+// ExportSnapshot writes cached data sorted by tags as versioned JSON, gzip compressed if compress is true.
+// Payloads of other types than types are skipped, the number of written entries is returned.
+func ExportSnapshot(w io.Writer, data map[string]CacheInfo, types PayloadTypes, compress bool) (int, error) {
+	snapshot := snapshotFile{
+		Version:   SnapshotVersion,
+		CreatedAt: time.Now(),
+		Entries:   make([]snapshotEntry, 0, len(data)),
+	}
+	for tag, info := range data {
+		stored, err := types.Encode(info)
+		if errors.Is(err, ErrUnknownPayloadType) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", tag, err)
+		}
+		snapshot.Entries = append(snapshot.Entries, snapshotEntry{Tag: tag, StoredCacheInfo: stored})
+	}
+	sort.Slice(snapshot.Entries, func(i, j int) bool {
+		return snapshot.Entries[i].Tag < snapshot.Entries[j].Tag
+	})
+	if !compress {
+		return len(snapshot.Entries), json.NewEncoder(w).Encode(snapshot)
+	}
+	gzipWriter := gzip.NewWriter(w)
+	err := json.NewEncoder(gzipWriter).Encode(snapshot)
+	if err != nil {
+		return 0, err
+	}
+	return len(snapshot.Entries), gzipWriter.Close()
+}
+
+// ImportSnapshot restores data of the snapshot (JSON or gzip) in the cache with its time stamps.
+// Entries of unknown types are skipped, the number of restored entries is returned.
+func ImportSnapshot(r io.Reader, cache Restorer, types PayloadTypes) (int, error) {
+	reader := bufio.NewReader(r)
+	magic, err := reader.Peek(len(gzipMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, err
+	}
+	var input io.Reader = reader
+	if bytes.Equal(magic, gzipMagic) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return 0, err
+		}
+		defer gzipReader.Close()
+		input = gzipReader
+	}
+	var snapshot snapshotFile
+	err = json.NewDecoder(input).Decode(&snapshot)
+	if err != nil {
+		return 0, err
+	}
+	if snapshot.Version != SnapshotVersion {
+		return 0, fmt.Errorf("%w: %d", ErrSnapshotVersion, snapshot.Version)
+	}
+	restored := 0
+	for _, entry := range snapshot.Entries {
+		info, err := types.Decode(entry.StoredCacheInfo)
+		if err != nil {
+			continue
+		}
+		cache.RestoreCacheDataInCache(entry.Tag, info)
+		restored++
+	}
+	return restored, nil
+}
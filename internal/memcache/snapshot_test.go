@@ -0,0 +1,61 @@
+package memcache_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+	"github.com/stretchr/testify/require"
+)
+
+type snapshotPayload struct {
+	Date time.Time
+	Rate string
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	types := memcache.NewPayloadTypes([]reflect.Type{reflect.TypeOf(snapshotPayload{})})
+	source := memcache.New()
+	source.Init()
+	payload := snapshotPayload{Date: time.Date(2023, 6, 22, 0, 0, 0, 0, time.UTC), Rate: "7.50"}
+	source.AddOrUpdatePayloadInCache("regular", payload)
+	source.AddOrUpdateHistoricalPayloadInCache("historical", payload)
+	// payload of not registered type is not exported
+	source.AddOrUpdatePayloadInCache("string", "payload")
+
+	for _, compress := range []bool{false, true} {
+		var snapshot bytes.Buffer
+		exported, err := memcache.ExportSnapshot(&snapshot, source.Snapshot(), types, compress)
+		require.NoError(t, err)
+		require.Equal(t, 2, exported)
+		require.Equal(t, !compress, strings.HasPrefix(snapshot.String(), `{"version":1,`))
+
+		target := memcache.New()
+		target.Init()
+		imported, err := memcache.ImportSnapshot(&snapshot, target, types)
+		require.NoError(t, err)
+		require.Equal(t, 2, imported)
+		for _, tag := range []string{"regular", "historical"} {
+			sourceInfo, ok := source.GetCacheDataInCache(tag)
+			require.Equal(t, true, ok)
+			info, ok := target.GetCacheDataInCache(tag)
+			require.Equal(t, true, ok, tag)
+			require.Equal(t, payload, info.Payload)
+			require.Equal(t, tag == "historical", info.Historical)
+			require.True(t, sourceInfo.InfoDTStamp.Equal(info.InfoDTStamp))
+		}
+		_, ok := target.GetCacheDataInCache("string")
+		require.Equal(t, false, ok)
+	}
+
+	target := memcache.New()
+	target.Init()
+	_, err := memcache.ImportSnapshot(strings.NewReader(`{"version":2,"entries":[]}`), target, types)
+	require.ErrorIs(t, err, memcache.ErrSnapshotVersion)
+	_, err = memcache.ImportSnapshot(strings.NewReader(`{"version":1,"entries":[`), target, types)
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	app "github.com/skolzkyi/cbrwsdltojson/internal/app"
 	customsoap "github.com/skolzkyi/cbrwsdltojson/internal/customsoap"
 	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
 	"go.uber.org/zap"
@@ -47,10 +48,30 @@ func (config *ConfigMock) GetInfoExpirTime() time.Duration {
 	return time.Second
 }
 
+func (config *ConfigMock) GetHistoricalInfoExpirTime() time.Duration {
+	return time.Second
+}
+
 func (config *ConfigMock) GetInfoClearTimeDelta() time.Duration {
 	return 3 * time.Second
 }
 
+func (config *ConfigMock) GetLatestDateCheckInterval() time.Duration {
+	return 0
+}
+
+func (config *ConfigMock) GetStaleWhileRevalidate(_ string) time.Duration {
+	return 0
+}
+
+func (config *ConfigMock) GetStaleIfError(_ string) time.Duration {
+	return 0
+}
+
+func (config *ConfigMock) GetCachePolicy(_ string) app.CachePolicy {
+	return app.CachePolicy{}
+}
+
 func (config *ConfigMock) GetCBRWSDLAddress() string {
 	return ""
 }
@@ -59,10 +80,6 @@ func (config *ConfigMock) GetLoggingOn() bool {
 	return true
 }
 
-func (config *ConfigMock) GetPermittedRequests() map[string]struct{} {
-	return nil
-}
-
 type LoggerMock struct {
 	loggingOn bool
 }
@@ -115,6 +132,15 @@ func (srsm *SoapRequestSenderMock) SoapCall(_ context.Context, action string, in
 			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetCursOnDateXMLResponse xmlns="http://web.cbr.ru/"><GetCursOnDateXMLResult><ValuteData OnDate="20230622" xmlns=""><ValuteCursOnDate><Vname>Австралийский доллар      </Vname><Vnom>1</Vnom><Vcurs>57.1445</Vcurs><Vcode>36</Vcode><VchCode>AUD</VchCode></ValuteCursOnDate><ValuteCursOnDate><Vname>Азербайджанский манат         </Vname><Vnom>1</Vnom><Vcurs>49.5569</Vcurs><Vcode>944</Vcode><VchCode>AZN</VchCode></ValuteCursOnDate></ValuteData></GetCursOnDateXMLResult></GetCursOnDateXMLResponse></soap:Body></soap:Envelope>`), nil
 		}
 		return nil, customsoap.ErrContextWSReqExpired
+	case "GetCursDynamicXML":
+		inputData, ok := input.(datastructures.GetCursDynamicXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.FromDate == cFromDate && inputData.ToDate == cToDate && inputData.ValutaCode == "R01235" {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetCursDynamicXMLResponse xmlns="http://web.cbr.ru/"><GetCursDynamicXMLResult><ValuteData ID="R01235" DateRange1="20230622" DateRange2="20230623" xmlns=""><ValuteCursDynamic><CursDate>2023-06-22T00:00:00Z</CursDate><Vcode>R01235    </Vcode><Vnom>1</Vnom><Vcurs>84.2467</Vcurs><VunitRate>84.2467</VunitRate></ValuteCursDynamic><ValuteCursDynamic><CursDate>2023-06-23T00:00:00Z</CursDate><Vcode>R01235    </Vcode><Vnom>1</Vnom><Vcurs>83.9499</Vcurs><VunitRate>83.9499</VunitRate></ValuteCursDynamic></ValuteData></GetCursDynamicXMLResult></GetCursDynamicXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
 	case "BiCurBaseXML":
 		inputData, ok := input.(datastructures.BiCurBaseXML)
 		if !ok {
@@ -165,13 +191,13 @@ func (srsm *SoapRequestSenderMock) SoapCall(_ context.Context, action string, in
 		if !ok {
 			return nil, ErrAssertion
 		}
-		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><EnumReutersValutesXMLResponse xmlns="http://web.cbr.ru/"><EnumReutersValutesXMLResult><ReutersValutesList xmlns=""><EnumRValutes><num_code>8</num_code><char_code>ALL</char_code><Title_ru>Албанский лек</Title_ru><Title_en>Albanian Lek</Title_en></EnumRValutes><EnumRValutes><num_code>12</num_code><char_code>DZD</char_code><Title_ru>Алжирский динар</Title_ru><Title_en>Algerian Dinar</Title_en></EnumRValutes></ReutersValutesList></EnumReutersValutesXMLResult></EnumReutersValutesXMLResponse></Body></soap:Envelope>`), nil
+		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><EnumReutersValutesXMLResponse xmlns="http://web.cbr.ru/"><EnumReutersValutesXMLResult><ReutersValutesList xmlns=""><EnumRValutes><num_code>8</num_code><char_code>ALL</char_code><Title_ru>Албанский лек</Title_ru><Title_en>Albanian Lek</Title_en></EnumRValutes><EnumRValutes><num_code>12</num_code><char_code>DZD</char_code><Title_ru>Алжирский динар</Title_ru><Title_en>Algerian Dinar</Title_en></EnumRValutes></ReutersValutesList></EnumReutersValutesXMLResult></EnumReutersValutesXMLResponse></soap:Body></soap:Envelope>`), nil
 	case "EnumValutesXML":
 		_, ok := input.(datastructures.EnumValutesXML)
 		if !ok {
 			return nil, ErrAssertion
 		}
-		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><EnumReutersValutesXMLResponse xmlns="http://web.cbr.ru/"><EnumValutesXMLResult><ValuteData xmlns=""><EnumValutes><Vcode>R01010</Vcode><Vname>Австралийский доллар</Vname><VEngname>Australian Dollar</VEngname><Vnom>1</Vnom><VcommonCode>R01010</VcommonCode><VnumCode>36</VnumCode><VcharCode>AUD</VcharCode></EnumValutes><EnumValutes><Vcode>R01015</Vcode><Vname>Австрийский шиллинг</Vname><VEngname>Austrian Shilling</VEngname><Vnom>1000</Vnom><VcommonCode>R01015</VcommonCode><VnumCode>40</VnumCode><VcharCode>ATS</VcharCode></EnumValutes></ValuteData></EnumValutesXMLResult></EnumReutersValutesXMLResponse></Body></soap:Envelope>`), nil
+		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><EnumReutersValutesXMLResponse xmlns="http://web.cbr.ru/"><EnumValutesXMLResult><ValuteData xmlns=""><EnumValutes><Vcode>R01010</Vcode><Vname>Австралийский доллар</Vname><VEngname>Australian Dollar</VEngname><Vnom>1</Vnom><VcommonCode>R01010</VcommonCode><VnumCode>36</VnumCode><VcharCode>AUD</VcharCode></EnumValutes><EnumValutes><Vcode>R01015</Vcode><Vname>Австрийский шиллинг</Vname><VEngname>Austrian Shilling</VEngname><Vnom>1000</Vnom><VcommonCode>R01015</VcommonCode><VnumCode>40</VnumCode><VcharCode>ATS</VcharCode></EnumValutes></ValuteData></EnumValutesXMLResult></EnumReutersValutesXMLResponse></soap:Body></soap:Envelope>`), nil
 	case "KeyRateXML":
 		inputData, ok := input.(datastructures.KeyRateXML)
 		if !ok {
@@ -370,7 +396,79 @@ func (srsm *SoapRequestSenderMock) SoapCall(_ context.Context, action string, in
 			return nil, ErrAssertion
 		}
 		if inputData.FromDate == "2022-02-11" && inputData.ToDate == "2022-02-24" { // nolint: goconst, nolintlint
-			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><SwapMonthTotalXMLResponse xmlns="http://web.cbr.ru/"><SwapMonthTotalXMLResult><SwapMonthTotal xmlns=""><SMT><D0>2022-02-11T00:00:00Z</D0><RUB>41208.1</RUB><USD>553.3</USD></SMT><SMT><D0>2022-02-24T00:00:00Z</D0><RUB>24113.5</RUB><USD>299.0</USD></SMT></SwapMonthTotal></SwapMonthTotalXMLResult></SwapMonthTotalXMLResponse></soap:Body></soap:Envelope`), nil
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><SwapMonthTotalXMLResponse xmlns="http://web.cbr.ru/"><SwapMonthTotalXMLResult><SwapMonthTotal xmlns=""><SMT><D0>2022-02-11T00:00:00Z</D0><RUB>41208.1</RUB><USD>553.3</USD></SMT><SMT><D0>2022-02-24T00:00:00Z</D0><RUB>24113.5</RUB><USD>299.0</USD></SMT></SwapMonthTotal></SwapMonthTotalXMLResult></SwapMonthTotalXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
+	case "Coins_baseXML":
+		inputData, ok := input.(datastructures.Coins_baseXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.FromDate == cFromDate && inputData.ToDate == cToDate {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><Coins_baseXMLResponse xmlns="http://web.cbr.ru/"><Coins_baseXMLResult><Coins_base xmlns=""><CB><date>2023-06-22T00:00:00Z</date><Cat_number>5111-0178</Cat_number><price>48000.00</price></CB><CB><date>2023-06-23T00:00:00Z</date><Cat_number>5111-0178</Cat_number><price>47500.00</price></CB></Coins_base></Coins_baseXMLResult></Coins_baseXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
+	case "FixingBaseXML":
+		inputData, ok := input.(datastructures.FixingBaseXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.FromDate == cFromDate && inputData.ToDate == cToDate {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><FixingBaseXMLResponse xmlns="http://web.cbr.ru/"><FixingBaseXMLResult><FixingBase xmlns=""><FB><D0>2023-06-22T00:00:00Z</D0><CodMet>1</CodMet><price>5231.5400</price></FB><FB><D0>2023-06-23T00:00:00Z</D0><CodMet>1</CodMet><price>5180.1100</price></FB></FixingBase></FixingBaseXMLResult></FixingBaseXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
+	case "GetReutersCursOnDateXML":
+		inputData, ok := input.(datastructures.GetReutersCursOnDateXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.OnDate == cFromDate {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetReutersCursOnDateXMLResponse xmlns="http://web.cbr.ru/"><GetReutersCursOnDateXMLResult><ReutersValutesData OnDate="20230622" xmlns=""><Currency><num_code>8</num_code><val>0.8454</val><dir>1</dir></Currency><Currency><num_code>12</num_code><val>0.6222</val><dir>1</dir></Currency></ReutersValutesData></GetReutersCursOnDateXMLResult></GetReutersCursOnDateXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
+	case "GetLatestDateTime":
+		_, ok := input.(datastructures.GetLatestDateTime)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetLatestDateTimeResponse xmlns="http://web.cbr.ru/"><GetLatestDateTimeResult>2023-06-23T00:00:00</GetLatestDateTimeResult></GetLatestDateTimeResponse></soap:Body></soap:Envelope>`), nil
+	case "GetLatestDateTimeSeld":
+		_, ok := input.(datastructures.GetLatestDateTimeSeld)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetLatestDateTimeSeldResponse xmlns="http://web.cbr.ru/"><GetLatestDateTimeSeldResult>2023-06-01T00:00:00</GetLatestDateTimeSeldResult></GetLatestDateTimeSeldResponse></soap:Body></soap:Envelope>`), nil
+	case "GetLatestReutersDateTime":
+		_, ok := input.(datastructures.GetLatestReutersDateTime)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetLatestReutersDateTimeResponse xmlns="http://web.cbr.ru/"><GetLatestReutersDateTimeResult>2023-06-22T00:00:00</GetLatestReutersDateTimeResult></GetLatestReutersDateTimeResponse></soap:Body></soap:Envelope>`), nil
+	case "MKRXML":
+		inputData, ok := input.(datastructures.MKRXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.FromDate == cFromDate && inputData.ToDate == cToDate {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><MKRXMLResponse xmlns="http://web.cbr.ru/"><MKRXMLResult><mkr_base xmlns=""><MKR><CDate>2023-06-22T00:00:00Z</CDate><p1>1</p1><d1>7.25</d1><d7>7.40</d7><d30>7.66</d30><d90>7.93</d90><d180>8.17</d180><d360>8.53</d360></MKR><MKR><CDate>2023-06-23T00:00:00Z</CDate><p1>1</p1><d1>7.27</d1><d7>7.41</d7><d30>7.67</d30><d90>7.95</d90><d180>8.20</d180><d360>8.56</d360></MKR></mkr_base></MKRXMLResult></MKRXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
+	case "GetSeldCursDynamicXML":
+		inputData, ok := input.(datastructures.GetSeldCursDynamicXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.FromDate == "2023-05-01" && inputData.ToDate == "2023-06-01" && inputData.ValutaCode == "R01030" {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetSeldCursDynamicXMLResponse xmlns="http://web.cbr.ru/"><GetSeldCursDynamicXMLResult><ValuteData ID="R01030" DateRange1="20230501" DateRange2="20230601" xmlns=""><ValuteCursDynamic><CursDate>2023-05-01T00:00:00Z</CursDate><Vcode>R01030    </Vcode><Vnom>100</Vnom><Vcurs>91.2345</Vcurs><VunitRate>0.912345</VunitRate></ValuteCursDynamic><ValuteCursDynamic><CursDate>2023-06-01T00:00:00Z</CursDate><Vcode>R01030    </Vcode><Vnom>100</Vnom><Vcurs>92.3456</Vcurs><VunitRate>0.923456</VunitRate></ValuteCursDynamic></ValuteData></GetSeldCursDynamicXMLResult></GetSeldCursDynamicXMLResponse></soap:Body></soap:Envelope>`), nil
+		}
+		return nil, customsoap.ErrContextWSReqExpired
+	case "GetSeldCursOnDateXML":
+		inputData, ok := input.(datastructures.GetSeldCursOnDateXML)
+		if !ok {
+			return nil, ErrAssertion
+		}
+		if inputData.OnDate == "2023-06-01" {
+			return []byte(`<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema"><soap:Body><GetSeldCursOnDateXMLResponse xmlns="http://web.cbr.ru/"><GetSeldCursOnDateXMLResult><ValuteData OnDate="20230601" xmlns=""><ValuteCursOnDate><Vname>Афганский афгани          </Vname><Vnom>100</Vnom><Vcurs>92.3456</Vcurs><Vcode>971</Vcode><VchCode>AFN</VchCode></ValuteCursOnDate><ValuteCursOnDate><Vname>Алжирский динар          </Vname><Vnom>100</Vnom><Vcurs>59.1234</Vcurs><Vcode>12</Vcode><VchCode>DZD</VchCode></ValuteCursOnDate></ValuteData></GetSeldCursOnDateXMLResult></GetSeldCursOnDateXMLResponse></soap:Body></soap:Envelope>`), nil
 		}
 		return nil, customsoap.ErrContextWSReqExpired
 	case "AllDataInfoXML":
@@ -0,0 +1,364 @@
+package rediscache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxIdleConns is the number of connections kept open between commands.
+const maxIdleConns = 8
+
+var (
+	ErrNil          = errors.New("redis: nil reply")
+	ErrClientClosed = errors.New("redis: client is closed")
+	ErrBadReply     = errors.New("redis: bad reply")
+)
+
+// Error is the error reply of the Redis server, the connection is usable after it.
+type Error string
+
+func (e Error) Error() string {
+	return "redis: " + string(e)
+}
+
+type Options struct {
+	Address  string
+	Password string
+	DB       int
+	// Timeout of dial and of every command
+	Timeout time.Duration
+}
+
+// Client sends commands to the Redis server by the RESP protocol through the pool of connections.
+type Client struct {
+	options Options
+	mu      sync.Mutex
+	idle    []*conn
+	closed  bool
+}
+
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+	writer  *bufio.Writer
+}
+
+func NewClient(options Options) *Client {
+	return &Client{options: options}
+}
+
+// Do sends the command and returns its reply: string, int64, []byte, []interface{} or nil.
+// Nil bulk reply is returned as ErrNil, error reply as Error.
+// The command is sent again on the new connection, if the idle connection is broken (for example, Redis was restarted).
+func (c *Client) Do(args ...string) (interface{}, error) {
+	var reply interface{}
+	err := c.withConn(func(cn *conn) error {
+		var err error
+		reply, err = cn.do(c.options.Timeout, args...)
+		return err
+	})
+	return reply, err
+}
+
+// Pipeline sends the commands by one write and returns their replies in the same order, so the commands
+// take one round trip. Nil bulk reply is returned as nil, error reply as Error in replies.
+// The commands are sent again on the new connection as in Do.
+func (c *Client) Pipeline(commands ...[]string) ([]interface{}, error) {
+	var replies []interface{}
+	err := c.withConn(func(cn *conn) error {
+		var err error
+		replies, err = cn.pipeline(c.options.Timeout, commands)
+		return err
+	})
+	return replies, err
+}
+
+// withConn runs fn on the connection, fn is run again on the new connection, if the idle connection is broken.
+func (c *Client) withConn(fn func(cn *conn) error) error {
+	cn, idle, err := c.getConn()
+	if err != nil {
+		return err
+	}
+	err = fn(cn)
+	if err != nil && isConnError(err) {
+		cn.netConn.Close()
+		if !idle {
+			return err
+		}
+		cn, err = c.dial()
+		if err != nil {
+			return err
+		}
+		err = fn(cn)
+		if err != nil && isConnError(err) {
+			cn.netConn.Close()
+			return err
+		}
+	}
+	c.putConn(cn)
+	return err
+}
+
+// isConnError reports, that the connection is not usable after the error.
+func isConnError(err error) bool {
+	var replyErr Error
+	return !errors.Is(err, ErrNil) && !errors.As(err, &replyErr)
+}
+
+// getConn returns the idle connection or the new one, idle is true for the idle connection.
+func (c *Client) getConn() (*conn, bool, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, false, ErrClientClosed
+	}
+	if len(c.idle) > 0 {
+		cn := c.idle[len(c.idle)-1]
+		c.idle = c.idle[:len(c.idle)-1]
+		c.mu.Unlock()
+		return cn, true, nil
+	}
+	c.mu.Unlock()
+	cn, err := c.dial()
+	return cn, false, err
+}
+
+func (c *Client) putConn(cn *conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || len(c.idle) >= maxIdleConns {
+		cn.netConn.Close()
+		return
+	}
+	c.idle = append(c.idle, cn)
+}
+
+// dial opens the connection, authenticates and selects the database.
+func (c *Client) dial() (*conn, error) {
+	netConn, err := net.DialTimeout("tcp", c.options.Address, c.options.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	cn := &conn{
+		netConn: netConn,
+		reader:  bufio.NewReader(netConn),
+		writer:  bufio.NewWriter(netConn),
+	}
+	if c.options.Password != "" {
+		_, err = cn.do(c.options.Timeout, "AUTH", c.options.Password)
+		if err != nil {
+			netConn.Close()
+			return nil, err
+		}
+	}
+	if c.options.DB != 0 {
+		_, err = cn.do(c.options.Timeout, "SELECT", strconv.Itoa(c.options.DB))
+		if err != nil {
+			netConn.Close()
+			return nil, err
+		}
+	}
+	return cn, nil
+}
+
+// Close closes idle connections, connections in use are closed on return.
+func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for _, cn := range c.idle {
+		cn.netConn.Close()
+	}
+	c.idle = nil
+}
+
+func (cn *conn) do(timeout time.Duration, args ...string) (interface{}, error) {
+	if timeout > 0 {
+		err := cn.netConn.SetDeadline(time.Now().Add(timeout))
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := writeCommand(cn.writer, args)
+	if err != nil {
+		return nil, err
+	}
+	return readReply(cn.reader)
+}
+
+func (cn *conn) pipeline(timeout time.Duration, commands [][]string) ([]interface{}, error) {
+	if timeout > 0 {
+		err := cn.netConn.SetDeadline(time.Now().Add(timeout))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, args := range commands {
+		appendCommand(cn.writer, args)
+	}
+	err := cn.writer.Flush()
+	if err != nil {
+		return nil, err
+	}
+	replies := make([]interface{}, len(commands))
+	for i := range replies {
+		replies[i], err = readReply(cn.reader)
+		var replyErr Error
+		switch {
+		case errors.As(err, &replyErr):
+			replies[i] = replyErr
+		case err != nil && isConnError(err):
+			return nil, err
+		}
+	}
+	return replies, nil
+}
+
+// Subscription receives messages of the channel on the dedicated connection.
+type Subscription struct {
+	cn *conn
+}
+
+// Subscribe opens the dedicated connection and subscribes it to the channel.
+func (c *Client) Subscribe(channel string) (*Subscription, error) {
+	cn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	reply, err := cn.do(c.options.Timeout, "SUBSCRIBE", channel)
+	if err == nil {
+		_, err = pushMessage(reply, "subscribe")
+	}
+	if err != nil {
+		cn.netConn.Close()
+		return nil, err
+	}
+	err = cn.netConn.SetDeadline(time.Time{})
+	if err != nil {
+		cn.netConn.Close()
+		return nil, err
+	}
+	return &Subscription{cn: cn}, nil
+}
+
+// Receive waits for the next message of the channel and returns its data.
+func (s *Subscription) Receive() (string, error) {
+	reply, err := readReply(s.cn.reader)
+	if err != nil {
+		return "", err
+	}
+	return pushMessage(reply, "message")
+}
+
+// Close closes the connection, Receive returns error after it.
+func (s *Subscription) Close() {
+	s.cn.netConn.Close()
+}
+
+// pushMessage checks the kind of the pushed reply [kind, channel, data] and returns its data.
+func pushMessage(reply interface{}, kind string) (string, error) {
+	items, ok := reply.([]interface{})
+	if !ok || len(items) != 3 {
+		return "", fmt.Errorf("%w: %v", ErrBadReply, reply)
+	}
+	replyKind, _ := items[0].([]byte)
+	if string(replyKind) != kind {
+		return "", fmt.Errorf("%w: %s instead of %s", ErrBadReply, replyKind, kind)
+	}
+	switch data := items[2].(type) {
+	case []byte:
+		return string(data), nil
+	case int64:
+		return strconv.FormatInt(data, 10), nil
+	default:
+		return "", fmt.Errorf("%w: %v", ErrBadReply, reply)
+	}
+}
+
+// writeCommand writes the command as the array of bulk strings.
+func writeCommand(writer *bufio.Writer, args []string) error {
+	appendCommand(writer, args)
+	return writer.Flush()
+}
+
+// appendCommand writes the command to the buffer without flush.
+func appendCommand(writer *bufio.Writer, args []string) {
+	fmt.Fprintf(writer, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(writer, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("%w: %q", ErrBadReply, line)
+	}
+	return line[:len(line)-2], nil
+}
+
+func readReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := readLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, Error(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		length, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrBadReply, line)
+		}
+		if length < 0 {
+			return nil, ErrNil
+		}
+		data := make([]byte, length+2)
+		_, err = io.ReadFull(reader, data)
+		if err != nil {
+			return nil, err
+		}
+		return data[:length], nil
+	case '*':
+		length, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrBadReply, line)
+		}
+		if length < 0 {
+			return nil, ErrNil
+		}
+		items := make([]interface{}, length)
+		for i := range items {
+			items[i], err = readReply(reader)
+			if errors.Is(err, ErrNil) {
+				continue
+			}
+			// error replies of commands of the transaction are items of the EXEC reply
+			var replyErr Error
+			if errors.As(err, &replyErr) {
+				items[i] = replyErr
+				continue
+			}
+			// the rest of the array is not read, so the connection is not usable
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrBadReply, err.Error())
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrBadReply, line)
+	}
+}
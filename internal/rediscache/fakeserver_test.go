@@ -0,0 +1,311 @@
+package rediscache
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FakeServer is the in-process Redis server for tests, it supports only commands used by RedisCache.
+type FakeServer struct {
+	listener    net.Listener
+	mu          sync.Mutex
+	strings     map[string]string
+	zsets       map[string]map[string]float64
+	subscribers map[string]map[*fakeConn]struct{}
+	conns       map[*fakeConn]struct{}
+	wg          sync.WaitGroup
+}
+
+type fakeConn struct {
+	netConn net.Conn
+	mu      sync.Mutex
+	writer  *bufio.Writer
+	// queued commands of the transaction after MULTI, nil out of the transaction
+	queued [][]string
+}
+
+// NewFakeServer starts FakeServer on the free local port.
+func NewFakeServer() (*FakeServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	fs := &FakeServer{
+		listener:    listener,
+		strings:     make(map[string]string),
+		zsets:       make(map[string]map[string]float64),
+		subscribers: make(map[string]map[*fakeConn]struct{}),
+		conns:       make(map[*fakeConn]struct{}),
+	}
+	fs.wg.Add(1)
+	go fs.serve()
+	return fs, nil
+}
+
+func (fs *FakeServer) Addr() string {
+	return fs.listener.Addr().String()
+}
+
+// Close stops the server and closes all connections.
+func (fs *FakeServer) Close() {
+	fs.listener.Close()
+	fs.DropConnections()
+	fs.wg.Wait()
+}
+
+// DropConnections closes all client connections, as it happens on restart of Redis.
+func (fs *FakeServer) DropConnections() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for fc := range fs.conns {
+		fc.netConn.Close()
+	}
+}
+
+// Get returns the value of the key, tests use it to check the stored data.
+func (fs *FakeServer) Get(key string) (string, bool) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	value, ok := fs.strings[key]
+	return value, ok
+}
+
+func (fs *FakeServer) serve() {
+	defer fs.wg.Done()
+	for {
+		netConn, err := fs.listener.Accept()
+		if err != nil {
+			return
+		}
+		fc := &fakeConn{netConn: netConn, writer: bufio.NewWriter(netConn)}
+		fs.mu.Lock()
+		fs.conns[fc] = struct{}{}
+		fs.mu.Unlock()
+		fs.wg.Add(1)
+		go fs.handle(fc)
+	}
+}
+
+func (fs *FakeServer) handle(fc *fakeConn) {
+	defer fs.wg.Done()
+	defer func() {
+		fs.mu.Lock()
+		delete(fs.conns, fc)
+		for _, subscribers := range fs.subscribers {
+			delete(subscribers, fc)
+		}
+		fs.mu.Unlock()
+		fc.netConn.Close()
+	}()
+	reader := bufio.NewReader(fc.netConn)
+	for {
+		reply, err := readReply(reader)
+		if err != nil {
+			return
+		}
+		items, ok := reply.([]interface{})
+		if !ok || len(items) == 0 {
+			fc.write(Error("ERR bad command"))
+			continue
+		}
+		args := make([]string, len(items))
+		for i, item := range items {
+			arg, _ := item.([]byte)
+			args[i] = string(arg)
+		}
+		if !fc.write(fs.execOrQueue(fc, args)) {
+			return
+		}
+	}
+}
+
+// execOrQueue runs the command or queues it in the transaction, commands of the transaction are run by EXEC at once.
+func (fs *FakeServer) execOrQueue(fc *fakeConn, args []string) interface{} {
+	command := strings.ToUpper(args[0])
+	switch {
+	case command == "MULTI" && fc.queued == nil:
+		fc.queued = make([][]string, 0)
+		return "OK"
+	case command == "EXEC" && fc.queued != nil:
+		queued := fc.queued
+		fc.queued = nil
+		fs.mu.Lock()
+		defer fs.mu.Unlock()
+		replies := make([]interface{}, 0, len(queued))
+		for _, queuedArgs := range queued {
+			replies = append(replies, fs.exec(fc, queuedArgs))
+		}
+		return replies
+	case fc.queued != nil:
+		fc.queued = append(fc.queued, args)
+		return "QUEUED"
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.exec(fc, args)
+}
+
+// exec runs the command and returns the reply, nil reply is written as nil bulk string. Server mutex must be locked.
+func (fs *FakeServer) exec(fc *fakeConn, args []string) interface{} {
+	command := strings.ToUpper(args[0])
+	args = args[1:]
+	switch {
+	case command == "PING":
+		return "PONG"
+	case command == "AUTH" && len(args) == 1, command == "SELECT" && len(args) == 1:
+		return "OK"
+	case command == "GET" && len(args) == 1:
+		value, ok := fs.strings[args[0]]
+		if !ok {
+			return nil
+		}
+		return []byte(value)
+	case command == "STRLEN" && len(args) == 1:
+		return int64(len(fs.strings[args[0]]))
+	case command == "SET" && len(args) >= 2:
+		fs.strings[args[0]] = args[1]
+		return "OK"
+	case command == "DEL" && len(args) >= 1:
+		var deleted int64
+		for _, key := range args {
+			_, okString := fs.strings[key]
+			_, okZSet := fs.zsets[key]
+			if okString || okZSet {
+				deleted++
+			}
+			delete(fs.strings, key)
+			delete(fs.zsets, key)
+		}
+		return deleted
+	case command == "ZADD" && len(args) >= 3 && len(args)%2 == 1:
+		return fs.zadd(args[0], args[1:])
+	case command == "ZREM" && len(args) >= 2:
+		var removed int64
+		for _, member := range args[1:] {
+			if _, ok := fs.zsets[args[0]][member]; ok {
+				delete(fs.zsets[args[0]], member)
+				removed++
+			}
+		}
+		return removed
+	case command == "ZRANGEBYSCORE" && len(args) == 3:
+		return fs.zrangeByScore(args[0], args[1], args[2], false)
+	case command == "ZRANGEBYSCORE" && len(args) == 4 && strings.ToUpper(args[3]) == "WITHSCORES":
+		return fs.zrangeByScore(args[0], args[1], args[2], true)
+	case command == "PUBLISH" && len(args) == 2:
+		message := []interface{}{[]byte("message"), []byte(args[0]), []byte(args[1])}
+		for subscriber := range fs.subscribers[args[0]] {
+			subscriber.write(message)
+		}
+		return int64(len(fs.subscribers[args[0]]))
+	case command == "SUBSCRIBE" && len(args) == 1:
+		if fs.subscribers[args[0]] == nil {
+			fs.subscribers[args[0]] = make(map[*fakeConn]struct{})
+		}
+		fs.subscribers[args[0]][fc] = struct{}{}
+		return []interface{}{[]byte("subscribe"), []byte(args[0]), int64(1)}
+	default:
+		return Error(fmt.Sprintf("ERR unknown command or wrong number of arguments '%s'", command))
+	}
+}
+
+func (fs *FakeServer) zadd(key string, args []string) interface{} {
+	if fs.zsets[key] == nil {
+		fs.zsets[key] = make(map[string]float64)
+	}
+	var added int64
+	for i := 0; i < len(args); i += 2 {
+		score, err := strconv.ParseFloat(args[i], 64)
+		if err != nil {
+			return Error("ERR value is not a valid float")
+		}
+		if _, ok := fs.zsets[key][args[i+1]]; !ok {
+			added++
+		}
+		fs.zsets[key][args[i+1]] = score
+	}
+	return added
+}
+
+func (fs *FakeServer) zrangeByScore(key string, minArg string, maxArg string, withScores bool) interface{} {
+	minScore, minExclusive, errMin := parseScoreBound(minArg)
+	maxScore, maxExclusive, errMax := parseScoreBound(maxArg)
+	if errMin != nil || errMax != nil {
+		return Error("ERR min or max is not a float")
+	}
+	members := make([]string, 0)
+	for member, score := range fs.zsets[key] {
+		if score < minScore || minExclusive && score == minScore {
+			continue
+		}
+		if score > maxScore || maxExclusive && score == maxScore {
+			continue
+		}
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		scoreI, scoreJ := fs.zsets[key][members[i]], fs.zsets[key][members[j]]
+		if scoreI != scoreJ {
+			return scoreI < scoreJ
+		}
+		return members[i] < members[j]
+	})
+	reply := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		reply = append(reply, []byte(member))
+		if withScores {
+			reply = append(reply, []byte(strconv.FormatFloat(fs.zsets[key][member], 'f', -1, 64)))
+		}
+	}
+	return reply
+}
+
+// parseScoreBound parses bound like "-inf", "+inf", "1.5" or "(1.5", "(" is exclusive bound.
+func parseScoreBound(bound string) (float64, bool, error) {
+	exclusive := strings.HasPrefix(bound, "(")
+	bound = strings.TrimPrefix(bound, "(")
+	switch bound {
+	case "-inf":
+		return math.Inf(-1), exclusive, nil
+	case "+inf", "inf":
+		return math.Inf(1), exclusive, nil
+	}
+	score, err := strconv.ParseFloat(bound, 64)
+	return score, exclusive, err
+}
+
+// write writes the reply, it returns false, if the connection is broken.
+func (fc *fakeConn) write(reply interface{}) bool {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	writeReply(fc.writer, reply)
+	return fc.writer.Flush() == nil
+}
+
+func writeReply(writer *bufio.Writer, reply interface{}) {
+	switch value := reply.(type) {
+	case nil:
+		writer.WriteString("$-1\r\n")
+	case string:
+		writer.WriteString("+" + value + "\r\n")
+	case Error:
+		writer.WriteString("-" + string(value) + "\r\n")
+	case int64:
+		writer.WriteString(":" + strconv.FormatInt(value, 10) + "\r\n")
+	case []byte:
+		writer.WriteString("$" + strconv.Itoa(len(value)) + "\r\n")
+		writer.Write(value)
+		writer.WriteString("\r\n")
+	case []interface{}:
+		writer.WriteString("*" + strconv.Itoa(len(value)) + "\r\n")
+		for _, item := range value {
+			writeReply(writer, item)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+package rediscache
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+)
+
+// resubscribeDelay is the pause before the next subscription to invalidations after the connection is lost.
+const resubscribeDelay = time.Second
+
+// Keys of data and indexes under the key prefix.
+const (
+	dataKeyPart            = ":data:"
+	regularIndexKeyPart    = ":regular"
+	historicalIndexKeyPart = ":historical"
+	invalidateChannelPart  = ":invalidate"
+)
+
+type Logger interface {
+	Info(msg string)
+	Warning(msg string)
+	Error(msg string)
+	Fatal(msg string)
+	GetZapLogger() *zap.SugaredLogger
+}
+
+// RedisCache is AppMemCache, which keeps data in Redis shared by all replicas of the service.
+// Data is stored as JSON with the name of its type, time stamps of data are kept in sorted sets
+// for removal by time stamp. Recently used data is also kept in the local MemCache, replicas publish
+// changed tags and drop them from their local caches. Redis errors are logged, the cache keeps working
+// in the local MemCache.
+type RedisCache struct {
+	logger       Logger
+	client       *Client
+	prefix       string
+	id           string
+	local        *memcache.MemCache
+	types        memcache.PayloadTypes
+	mu           sync.Mutex
+	subscription *Subscription
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+// New creates RedisCache with keys under the prefix, payloads of other types than payloadTypes are cached only locally.
+func New(logger Logger, client *Client, prefix string, local *memcache.MemCache, payloadTypes []reflect.Type) *RedisCache {
+	id := make([]byte, 8)
+	_, err := rand.Read(id)
+	if err != nil {
+		logger.Error("redis cache: replica id: " + err.Error())
+	}
+	return &RedisCache{
+		logger: logger,
+		client: client,
+		prefix: prefix,
+		id:     hex.EncodeToString(id),
+		local:  local,
+		types:  memcache.NewPayloadTypes(payloadTypes),
+	}
+}
+
+// Init clears the local cache and subscribes to invalidations of other replicas.
+func (rc *RedisCache) Init() {
+	rc.local.Init()
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.stop != nil {
+		return
+	}
+	rc.stop = make(chan struct{})
+	rc.done = make(chan struct{})
+	subscription, err := rc.client.Subscribe(rc.invalidateChannel())
+	if err != nil {
+		rc.logger.Error("redis cache: subscribe: " + err.Error())
+	}
+	rc.subscription = subscription
+	go rc.listen(subscription)
+}
+
+// listen drops tags invalidated by other replicas from the local cache and resubscribes after errors.
+func (rc *RedisCache) listen(subscription *Subscription) {
+	defer close(rc.done)
+	for {
+		if subscription != nil {
+			err := rc.receive(subscription)
+			subscription.Close()
+			rc.logger.Warning("redis cache: invalidations: " + err.Error())
+		}
+		select {
+		case <-rc.stop:
+			return
+		case <-time.After(resubscribeDelay):
+		}
+		var err error
+		subscription, err = rc.client.Subscribe(rc.invalidateChannel())
+		if err != nil {
+			rc.logger.Error("redis cache: subscribe: " + err.Error())
+			continue
+		}
+		if !rc.setSubscription(subscription) {
+			subscription.Close()
+			return
+		}
+		// invalidations could be lost without subscription
+		rc.local.Init()
+	}
+}
+
+func (rc *RedisCache) setSubscription(subscription *Subscription) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	select {
+	case <-rc.stop:
+		return false
+	default:
+	}
+	rc.subscription = subscription
+	return true
+}
+
+func (rc *RedisCache) receive(subscription *Subscription) error {
+	for {
+		message, err := subscription.Receive()
+		if err != nil {
+			return err
+		}
+		id, tag, found := strings.Cut(message, " ")
+		if !found {
+			rc.logger.Warning("redis cache: bad invalidation: " + message)
+			continue
+		}
+		if id != rc.id {
+			rc.local.RemovePayloadInCache(tag)
+		}
+	}
+}
+
+// Close stops receiving of invalidations and closes connections to Redis.
+func (rc *RedisCache) Close() {
+	rc.mu.Lock()
+	if rc.stop == nil {
+		rc.mu.Unlock()
+		rc.client.Close()
+		return
+	}
+	select {
+	case <-rc.stop:
+	default:
+		close(rc.stop)
+	}
+	if rc.subscription != nil {
+		rc.subscription.Close()
+	}
+	rc.mu.Unlock()
+	<-rc.done
+	rc.client.Close()
+}
+
+func (rc *RedisCache) dataKey(tag string) string {
+	return rc.prefix + dataKeyPart + tag
+}
+
+func (rc *RedisCache) indexKey(historical bool) string {
+	if historical {
+		return rc.prefix + historicalIndexKeyPart
+	}
+	return rc.prefix + regularIndexKeyPart
+}
+
+func (rc *RedisCache) invalidateChannel() string {
+	return rc.prefix + invalidateChannelPart
+}
+
+// score is the time stamp in milliseconds, it is exact in float64 of the sorted set.
+func score(stamp time.Time) string {
+	return strconv.FormatInt(stamp.UnixMilli(), 10)
+}
+
+func (rc *RedisCache) AddOrUpdatePayloadInCache(tag string, payload interface{}) bool {
+	return rc.addOrUpdatePayload(tag, memcache.CacheInfo{Payload: payload, InfoDTStamp: time.Now()})
+}
+
+func (rc *RedisCache) AddOrUpdateHistoricalPayloadInCache(tag string, payload interface{}) bool {
+	return rc.addOrUpdatePayload(tag, memcache.CacheInfo{Payload: payload, InfoDTStamp: time.Now(), Historical: true})
+}
+
+// RestoreCacheDataInCache adds or updates cached data with its time stamp, for example, imported from the snapshot.
+func (rc *RedisCache) RestoreCacheDataInCache(tag string, info memcache.CacheInfo) bool { //nolint: gocritic
+	return rc.addOrUpdatePayload(tag, info)
+}
+
+func (rc *RedisCache) addOrUpdatePayload(tag string, info memcache.CacheInfo) bool { //nolint: gocritic
+	ok := rc.local.RestoreCacheDataInCache(tag, info)
+	stored, err := rc.types.Encode(info)
+	if err != nil {
+		rc.logger.Warning("redis cache: " + tag + " is cached only locally: " + err.Error())
+		return ok
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		rc.logger.Error("redis cache: " + err.Error())
+		return ok
+	}
+	err = rc.execAndPublish([]string{tag},
+		[]string{"SET", rc.dataKey(tag), string(data)},
+		[]string{"ZADD", rc.indexKey(info.Historical), score(info.InfoDTStamp), tag},
+		[]string{"ZREM", rc.indexKey(!info.Historical), tag},
+	)
+	if err != nil {
+		rc.logger.Error("redis cache: " + tag + ": " + err.Error())
+	}
+	// true is update
+	return ok
+}
+
+// execAndPublish runs the commands in MULTI/EXEC transaction and publishes invalidations of the tags by one round trip,
+// invalidations are published even if the transaction fails.
+func (rc *RedisCache) execAndPublish(tags []string, commands ...[]string) error {
+	pipeline := make([][]string, 0, len(commands)+len(tags)+2)
+	pipeline = append(pipeline, []string{"MULTI"})
+	pipeline = append(pipeline, commands...)
+	pipeline = append(pipeline, []string{"EXEC"})
+	for _, tag := range tags {
+		pipeline = append(pipeline, []string{"PUBLISH", rc.invalidateChannel(), rc.id + " " + tag})
+	}
+	replies, err := rc.client.Pipeline(pipeline...)
+	if err != nil {
+		return err
+	}
+	return replyError(replies)
+}
+
+// replyError returns the first error reply, replies of the transaction are items of the EXEC reply.
+func replyError(replies []interface{}) error {
+	for _, reply := range replies {
+		switch value := reply.(type) {
+		case Error:
+			return value
+		case []interface{}:
+			err := replyError(value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (rc *RedisCache) RemovePayloadInCache(tag string) {
+	rc.local.RemovePayloadInCache(tag)
+	err := rc.execAndPublish([]string{tag},
+		[]string{"DEL", rc.dataKey(tag)},
+		[]string{"ZREM", rc.indexKey(false), tag},
+		[]string{"ZREM", rc.indexKey(true), tag},
+	)
+	if err != nil {
+		rc.logger.Error("redis cache: " + tag + ": " + err.Error())
+	}
+}
+
+// RemoveAllPayloadInCacheByTimeStamp removes not historical data cached before the control time,
+// the number of entries removed in Redis is returned.
+func (rc *RedisCache) RemoveAllPayloadInCacheByTimeStamp(controlTime time.Time) int {
+	rc.local.RemoveAllPayloadInCacheByTimeStamp(controlTime)
+	return rc.removePayloadByTimeStamp(controlTime, false)
+}
+
+func (rc *RedisCache) RemoveHistoricalPayloadInCacheByTimeStamp(controlTime time.Time) int {
+	rc.local.RemoveHistoricalPayloadInCacheByTimeStamp(controlTime)
+	return rc.removePayloadByTimeStamp(controlTime, true)
+}
+
+func (rc *RedisCache) removePayloadByTimeStamp(controlTime time.Time, historical bool) int {
+	tags, err := rc.indexTags(historical, "-inf", "("+score(controlTime))
+	if err != nil {
+		rc.logger.Error("redis cache: remove by time stamp: " + err.Error())
+		return 0
+	}
+	if len(tags) == 0 {
+		return 0
+	}
+	dataKeys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		dataKeys = append(dataKeys, rc.dataKey(tag))
+	}
+	err = rc.execAndPublish(tags,
+		append([]string{"DEL"}, dataKeys...),
+		append([]string{"ZREM", rc.indexKey(historical)}, tags...),
+	)
+	if err != nil {
+		rc.logger.Error("redis cache: remove by time stamp: " + err.Error())
+		return 0
+	}
+	return len(tags)
+}
+
+func (rc *RedisCache) indexTags(historical bool, minScore string, maxScore string) ([]string, error) {
+	reply, err := rc.client.Do("ZRANGEBYSCORE", rc.indexKey(historical), minScore, maxScore)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := reply.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrBadReply, reply)
+	}
+	tags := make([]string, 0, len(items))
+	for _, item := range items {
+		tag, ok := item.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrBadReply, item)
+		}
+		tags = append(tags, string(tag))
+	}
+	return tags, nil
+}
+
+// GetAllCacheEntries returns descriptions of all data in Redis, size of the entry is the size of its stored JSON.
+func (rc *RedisCache) GetAllCacheEntries() []memcache.EntryInfo {
+	entries := make([]memcache.EntryInfo, 0)
+	for _, historical := range []bool{false, true} {
+		reply, err := rc.client.Do("ZRANGEBYSCORE", rc.indexKey(historical), "-inf", "+inf", "WITHSCORES")
+		if err != nil {
+			rc.logger.Error("redis cache: " + err.Error())
+			return entries
+		}
+		items, ok := reply.([]interface{})
+		if !ok || len(items)%2 != 0 {
+			rc.logger.Error(fmt.Sprintf("redis cache: %v: %v", ErrBadReply, reply))
+			return entries
+		}
+		for i := 0; i < len(items); i += 2 {
+			tag, okTag := items[i].([]byte)
+			stampScore, okScore := items[i+1].([]byte)
+			stamp, err := strconv.ParseFloat(string(stampScore), 64)
+			if !okTag || !okScore || err != nil {
+				rc.logger.Error(fmt.Sprintf("redis cache: %v: %v", ErrBadReply, reply))
+				return entries
+			}
+			entries = append(entries, memcache.EntryInfo{
+				Tag:         string(tag),
+				InfoDTStamp: time.UnixMilli(int64(stamp)),
+				Historical:  historical,
+			})
+		}
+	}
+	rc.setDataSizes(entries)
+	return entries
+}
+
+// setDataSizes sets sizes of entries by one round trip.
+func (rc *RedisCache) setDataSizes(entries []memcache.EntryInfo) {
+	if len(entries) == 0 {
+		return
+	}
+	commands := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		commands = append(commands, []string{"STRLEN", rc.dataKey(entry.Tag)})
+	}
+	replies, err := rc.client.Pipeline(commands...)
+	if err != nil {
+		rc.logger.Error("redis cache: " + err.Error())
+		return
+	}
+	for i, reply := range replies {
+		entries[i].Size, _ = reply.(int64)
+	}
+}
+
+// GetCacheDataInCache returns data from the local cache or from Redis, data from Redis is kept locally.
+func (rc *RedisCache) GetCacheDataInCache(tag string) (memcache.CacheInfo, bool) {
+	info, ok := rc.local.GetCacheDataInCache(tag)
+	if ok {
+		return info, ok
+	}
+	info, ok = rc.load(tag)
+	if ok {
+		rc.local.RestoreCacheDataInCache(tag, info)
+	}
+	return info, ok
+}
+
+// PeekCacheDataInCache returns data from the local cache or from Redis, the local cache is not changed.
+func (rc *RedisCache) PeekCacheDataInCache(tag string) (memcache.CacheInfo, bool) {
+	info, ok := rc.local.PeekCacheDataInCache(tag)
+	if ok {
+		return info, ok
+	}
+	return rc.load(tag)
+}
+
+// Snapshot returns copy of all data in Redis by tags, the data is not kept locally.
+func (rc *RedisCache) Snapshot() map[string]memcache.CacheInfo {
+	snapshot := make(map[string]memcache.CacheInfo)
+	for _, entry := range rc.GetAllCacheEntries() {
+		info, ok := rc.load(entry.Tag)
+		if ok {
+			snapshot[entry.Tag] = info
+		}
+	}
+	return snapshot
+}
+
+// load reads data from Redis.
+func (rc *RedisCache) load(tag string) (memcache.CacheInfo, bool) {
+	reply, err := rc.client.Do("GET", rc.dataKey(tag))
+	if errors.Is(err, ErrNil) {
+		return memcache.CacheInfo{}, false
+	}
+	if err != nil {
+		rc.logger.Error("redis cache: " + tag + ": " + err.Error())
+		return memcache.CacheInfo{}, false
+	}
+	data, ok := reply.([]byte)
+	if !ok {
+		rc.logger.Error(fmt.Sprintf("redis cache: %s: %v: %v", tag, ErrBadReply, reply))
+		return memcache.CacheInfo{}, false
+	}
+	var stored memcache.StoredCacheInfo
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		rc.logger.Warning("redis cache: skip " + tag + ": " + err.Error())
+		return memcache.CacheInfo{}, false
+	}
+	info, err := rc.types.Decode(stored)
+	if err != nil {
+		rc.logger.Warning("redis cache: skip " + tag + ": " + err.Error())
+		return memcache.CacheInfo{}, false
+	}
+	return info, true
+}
+
+func (rc *RedisCache) PrintAllCacheKeys() {
+	for _, historical := range []bool{false, true} {
+		tags, err := rc.indexTags(historical, "-inf", "+inf")
+		if err != nil {
+			rc.logger.Error("redis cache: " + err.Error())
+			return
+		}
+		for _, tag := range tags {
+			fmt.Println(tag)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package rediscache_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
+	memcache "github.com/skolzkyi/cbrwsdltojson/internal/memcache"
+	mocks "github.com/skolzkyi/cbrwsdltojson/internal/mocks"
+	rediscache "github.com/skolzkyi/cbrwsdltojson/internal/rediscache"
+	"github.com/stretchr/testify/require"
+)
+
+const keyPrefix = "cbrwsdltojson"
+
+var payloadTypes = []reflect.Type{
+	reflect.TypeOf(datastructures.KeyRateXMLResult{}),
+	reflect.TypeOf(datastructures.GetCursOnDateXMLResult{}),
+}
+
+func startFakeServer(t *testing.T) *rediscache.FakeServer {
+	t.Helper()
+	server, err := rediscache.NewFakeServer()
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// openReplica creates the cache of one replica of the service.
+func openReplica(t *testing.T, server *rediscache.FakeServer) *rediscache.RedisCache {
+	t.Helper()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	client := rediscache.NewClient(rediscache.Options{Address: server.Addr(), Timeout: time.Second})
+	redisCache := rediscache.New(loggerMock, client, keyPrefix, memcache.New(), payloadTypes)
+	redisCache.Init()
+	t.Cleanup(redisCache.Close)
+	return redisCache
+}
+
+func keyRateResult(rate string) datastructures.KeyRateXMLResult {
+	return datastructures.KeyRateXMLResult{KR: []datastructures.KeyRateXMLResultElem{
+		{DT: time.Date(2023, 6, 22, 0, 0, 0, 0, time.FixedZone("", 3*60*60)), Rate: rate},
+	}}
+}
+
+// cachedRate returns the rate of cached KeyRateXMLResult, it is empty, if there is no such data.
+func cachedRate(redisCache *rediscache.RedisCache, tag string) string {
+	info, ok := redisCache.GetCacheDataInCache(tag)
+	if !ok {
+		return ""
+	}
+	keyRate, ok := info.Payload.(datastructures.KeyRateXMLResult)
+	if !ok || len(keyRate.KR) == 0 {
+		return ""
+	}
+	return keyRate.KR[0].Rate
+}
+
+func requireEventuallyRate(t *testing.T, redisCache *rediscache.RedisCache, tag string, rate string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return cachedRate(redisCache, tag) == rate
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRedisCache(t *testing.T) {
+	t.Parallel()
+	cursOnDate := datastructures.GetCursOnDateXMLResult{OnDate: "20230622", ValuteCursOnDate: []datastructures.GetCursOnDateXMLResultElem{
+		{Vname: "Австралийский доллар", Vnom: 1, Vcurs: "57.1445", Vcode: "36", VchCode: "AUD"},
+	}}
+
+	t.Run("TestRedisCache: SharedBetweenReplicas", func(t *testing.T) {
+		t.Parallel()
+		server := startFakeServer(t)
+		first := openReplica(t, server)
+		second := openReplica(t, server)
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("7.50"))
+		first.AddOrUpdateHistoricalPayloadInCache("GetCursOnDateXML", cursOnDate)
+		keyRateInfo, ok := first.GetCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+
+		info, ok := second.GetCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+		require.Equal(t, false, info.Historical)
+		require.True(t, keyRateInfo.InfoDTStamp.Equal(info.InfoDTStamp))
+		keyRate, ok := info.Payload.(datastructures.KeyRateXMLResult)
+		require.Equal(t, true, ok)
+		require.True(t, keyRateResult("7.50").KR[0].DT.Equal(keyRate.KR[0].DT))
+		info, ok = second.GetCacheDataInCache("GetCursOnDateXML")
+		require.Equal(t, true, ok)
+		require.Equal(t, true, info.Historical)
+		require.Equal(t, cursOnDate, info.Payload)
+		_, ok = second.GetCacheDataInCache("unknown")
+		require.Equal(t, false, ok)
+		entries := second.GetAllCacheEntries()
+		require.Len(t, entries, 2)
+		require.Equal(t, "KeyRateXML", entries[0].Tag)
+		require.Equal(t, keyRateInfo.InfoDTStamp.UnixMilli(), entries[0].InfoDTStamp.UnixMilli())
+		require.Equal(t, false, entries[0].Historical)
+		require.Positive(t, entries[0].Size)
+		require.Equal(t, "GetCursOnDateXML", entries[1].Tag)
+		require.Equal(t, true, entries[1].Historical)
+
+		// payload of not registered type is cached only locally
+		first.AddOrUpdatePayloadInCache("int", 1)
+		_, ok = first.GetCacheDataInCache("int")
+		require.Equal(t, true, ok)
+		_, ok = second.GetCacheDataInCache("int")
+		require.Equal(t, false, ok)
+		_, ok = server.Get(keyPrefix + ":data:int")
+		require.Equal(t, false, ok)
+	})
+	t.Run("TestRedisCache: Invalidation", func(t *testing.T) {
+		t.Parallel()
+		server := startFakeServer(t)
+		first := openReplica(t, server)
+		second := openReplica(t, server)
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("7.50"))
+		// the second replica keeps the data locally
+		require.Equal(t, "7.50", cachedRate(second, "KeyRateXML"))
+
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("8.00"))
+		requireEventuallyRate(t, second, "KeyRateXML", "8.00")
+
+		first.RemovePayloadInCache("KeyRateXML")
+		requireEventuallyRate(t, second, "KeyRateXML", "")
+	})
+	t.Run("TestRedisCache: RemoveByTimeStamp", func(t *testing.T) {
+		t.Parallel()
+		server := startFakeServer(t)
+		first := openReplica(t, server)
+		second := openReplica(t, server)
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("7.50"))
+		first.AddOrUpdateHistoricalPayloadInCache("GetCursOnDateXML", cursOnDate)
+		time.Sleep(2 * time.Millisecond)
+
+		second.RemoveAllPayloadInCacheByTimeStamp(time.Now())
+		_, ok := server.Get(keyPrefix + ":data:KeyRateXML")
+		require.Equal(t, false, ok)
+		requireEventuallyRate(t, first, "KeyRateXML", "")
+		_, ok = second.GetCacheDataInCache("GetCursOnDateXML")
+		require.Equal(t, true, ok)
+
+		second.RemoveHistoricalPayloadInCacheByTimeStamp(time.Now())
+		_, ok = server.Get(keyPrefix + ":data:GetCursOnDateXML")
+		require.Equal(t, false, ok)
+		_, ok = second.GetCacheDataInCache("GetCursOnDateXML")
+		require.Equal(t, false, ok)
+	})
+	t.Run("TestRedisCache: Peek", func(t *testing.T) {
+		t.Parallel()
+		server := startFakeServer(t)
+		first := openReplica(t, server)
+		second := openReplica(t, server)
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("7.50"))
+		info, ok := second.PeekCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+		require.Equal(t, keyRateResult("7.50").KR[0].Rate, info.Payload.(datastructures.KeyRateXMLResult).KR[0].Rate)
+
+		// peeked data is not kept locally: it is not found after removal in Redis without invalidation
+		client := rediscache.NewClient(rediscache.Options{Address: server.Addr(), Timeout: time.Second})
+		defer client.Close()
+		_, err := client.Do("DEL", keyPrefix+":data:KeyRateXML")
+		require.NoError(t, err)
+		_, ok = second.GetCacheDataInCache("KeyRateXML")
+		require.Equal(t, false, ok)
+		_, ok = first.PeekCacheDataInCache("KeyRateXML")
+		require.Equal(t, true, ok)
+	})
+	t.Run("TestRedisCache: Pipeline", func(t *testing.T) {
+		t.Parallel()
+		server := startFakeServer(t)
+		client := rediscache.NewClient(rediscache.Options{Address: server.Addr(), Timeout: time.Second})
+		defer client.Close()
+		replies, err := client.Pipeline(
+			[]string{"MULTI"},
+			[]string{"SET", "key", "value"},
+			[]string{"UNKNOWN"},
+			[]string{"EXEC"},
+			[]string{"GET", "key"},
+			[]string{"GET", "missing"},
+		)
+		require.NoError(t, err)
+		require.Len(t, replies, 6)
+		require.Equal(t, "QUEUED", replies[1])
+		// error reply of the command of the transaction does not break the connection
+		exec, ok := replies[3].([]interface{})
+		require.Equal(t, true, ok)
+		require.Equal(t, "OK", exec[0])
+		require.IsType(t, rediscache.Error(""), exec[1])
+		require.Equal(t, []byte("value"), replies[4])
+		require.Nil(t, replies[5])
+		reply, err := client.Do("GET", "key")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), reply)
+
+		redisCache := openReplica(t, server)
+		for day := 1; day <= 100; day++ {
+			redisCache.AddOrUpdatePayloadInCache(fmt.Sprintf("KeyRateXML%d", day), keyRateResult("7.50"))
+		}
+		entries := redisCache.GetAllCacheEntries()
+		require.Len(t, entries, 100)
+		for _, entry := range entries {
+			require.Positive(t, entry.Size)
+		}
+		time.Sleep(2 * time.Millisecond)
+		require.Equal(t, 100, redisCache.RemoveAllPayloadInCacheByTimeStamp(time.Now()))
+		require.Len(t, redisCache.GetAllCacheEntries(), 0)
+	})
+	t.Run("TestRedisCache: Reconnect", func(t *testing.T) {
+		t.Parallel()
+		server := startFakeServer(t)
+		first := openReplica(t, server)
+		second := openReplica(t, server)
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("7.50"))
+		require.Equal(t, "7.50", cachedRate(second, "KeyRateXML"))
+
+		server.DropConnections()
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("8.00"))
+		_, ok := server.Get(keyPrefix + ":data:KeyRateXML")
+		require.Equal(t, true, ok)
+		// the second replica resubscribes and drops the local data, which could miss invalidations
+		requireEventuallyRate(t, second, "KeyRateXML", "8.00")
+		first.AddOrUpdatePayloadInCache("KeyRateXML", keyRateResult("8.50"))
+		requireEventuallyRate(t, second, "KeyRateXML", "8.50")
+	})
+}
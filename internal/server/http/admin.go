@@ -0,0 +1,184 @@
+package internalhttp
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	app "github.com/skolzkyi/cbrwsdltojson/internal/app"
+)
+
+// AdminTokenHeader is the header with the token of cache administration.
+const AdminTokenHeader = "X-Admin-Token"
+
+var (
+	ErrAdminAPIDisabled = errors.New("admin API is disabled, ADMIN_TOKEN is not set")
+	ErrBadAdminToken    = errors.New("bad admin token")
+	ErrNoCacheFilter    = errors.New("no method or prefix of evicted cache entries")
+)
+
+type evictedCacheEntries struct {
+	Evicted int `json:"evicted"`
+}
+
+// adminMiddleware permits requests with the admin token, admin API is disabled without the token in config.
+func (s *Server) adminMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminToken := s.Config.GetAdminToken()
+		if adminToken == "" {
+			apiErrHandler(ErrAdminAPIDisabled, &w)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(AdminTokenHeader)), []byte(adminToken)) != 1 {
+			apiErrHandler(ErrBadAdminToken, &w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}
+}
+
+func cacheFilter(r *http.Request) app.CacheFilter {
+	return app.CacheFilter{
+		Method: r.URL.Query().Get("method"),
+		Prefix: r.URL.Query().Get("prefix"),
+	}
+}
+
+// CacheKeys lists cache entries on GET and evicts them on DELETE, entries are selected by method and key prefix.
+func (s *Server) CacheKeys(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	filter := cacheFilter(r)
+	switch r.Method {
+	case http.MethodGet:
+		entries, err := s.app.GetCacheEntries(filter)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		err = s.WriteDataToOutputJSON(entries, w)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	case http.MethodDelete:
+		if filter.Method == "" && filter.Prefix == "" {
+			apiErrHandler(ErrNoCacheFilter, &w)
+			return
+		}
+		evicted, err := s.app.EvictCacheEntries(filter)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		err = s.WriteDataToOutputJSON(evictedCacheEntries{Evicted: evicted}, w)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	default:
+		apiErrHandler(ErrUnsupportedMethod, &w)
+		return
+	}
+}
+
+// CacheEntry returns the cache entry with its payload by the key.
+func (s *Server) CacheEntry(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	switch r.Method {
+	case http.MethodGet:
+		entry, err := s.app.GetCacheEntry(r.URL.Query().Get("key"))
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		err = s.WriteDataToOutputJSON(entry, w)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	default:
+		apiErrHandler(ErrUnsupportedMethod, &w)
+		return
+	}
+}
+
+// Cache flushes all cache entries on DELETE.
+func (s *Server) Cache(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	switch r.Method {
+	case http.MethodDelete:
+		err := s.WriteDataToOutputJSON(evictedCacheEntries{Evicted: s.app.FlushCache()}, w)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	default:
+		apiErrHandler(ErrUnsupportedMethod, &w)
+		return
+	}
+}
+
+// CacheSnapshot returns the snapshot of all cached data, gzip compressed unless gzip=false.
+func (s *Server) CacheSnapshot(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	switch r.Method {
+	case http.MethodGet:
+		compress := r.URL.Query().Get("gzip") != "false"
+		// snapshot is buffered to respond with error status on its error
+		var snapshot bytes.Buffer
+		_, err := s.app.ExportCacheSnapshot(&snapshot, compress)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		fileName := "cache-snapshot-" + time.Now().UTC().Format("20060102T150405") + ".json"
+		w.Header().Set("Content-Type", "application/json")
+		if compress {
+			fileName += ".gz"
+			w.Header().Set("Content-Type", "application/gzip")
+		}
+		w.Header().Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+		_, err = w.Write(snapshot.Bytes())
+		if err != nil {
+			s.logg.Error("server CacheSnapshot error: " + err.Error())
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	default:
+		apiErrHandler(ErrUnsupportedMethod, &w)
+		return
+	}
+}
+
+func (s *Server) CacheStats(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	switch r.Method {
+	case http.MethodGet:
+		err := s.WriteDataToOutputJSON(s.app.GetCacheStats(), w)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	default:
+		apiErrHandler(ErrUnsupportedMethod, &w)
+		return
+	}
+}
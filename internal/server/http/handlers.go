@@ -5,46 +5,79 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	helpers "github.com/skolzkyi/cbrwsdltojson/helpers"
+	app "github.com/skolzkyi/cbrwsdltojson/internal/app"
+	customsoap "github.com/skolzkyi/cbrwsdltojson/internal/customsoap"
 	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
 )
 
-type requestData interface {
-	Init()
-	Validate() error
-}
-
-type blLayerMethod func(context.Context, interface{}, string) (interface{}, error)
+// Headers of the cached response.
+const (
+	CacheStatusHeader      = "X-Cache"
+	CacheStaleReasonHeader = "X-Cache-Stale-Reason"
+)
 
-// without parameters.
-type blLayerMethodWP func(context.Context) (interface{}, error)
+// APIKeyHeader is the header with API key of the client, which chooses access policy.
+const APIKeyHeader = "X-API-Key"
 
 var (
 	ErrInJSONBadParse        = errors.New("error parsing input json")
 	ErrOutJSONBadParse       = errors.New("error parsing output json")
 	ErrUnsupportedMethod     = errors.New("http unsupported method")
 	ErrNoSOAPActionInRequest = errors.New("no SOAPAction in request")
+	ErrNoCircuitBreaker      = errors.New("circuit breaker is not configured")
 )
 
+// errStatusCode maps error to HTTP status: client errors, CBR WS unavailability or internal error.
+func errStatusCode(err error) int {
+	var soapFault *customsoap.SOAPFault
+	switch {
+	case errors.Is(err, ErrBadAdminToken) || errors.Is(err, app.ErrAPIKeyRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, app.ErrMethodProhibited) || errors.Is(err, app.ErrUnknownAPIKey):
+		return http.StatusForbidden
+	case errors.Is(err, ErrNoCircuitBreaker) || errors.Is(err, app.ErrMethodNotFound) || errors.Is(err, ErrAdminAPIDisabled) || errors.Is(err, app.ErrCacheEntryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, customsoap.ErrCircuitOpen):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, app.ErrContextWSReqExpired) || errors.Is(err, customsoap.ErrContextWSReqExpired) || errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.As(err, &soapFault) || errors.Is(err, customsoap.ErrBadHTTPStatus) || errors.Is(err, app.ErrStartNodeNotFound):
+		return http.StatusBadGateway
+	case errors.Is(err, datastructures.ErrBadInputDateData) || errors.Is(err, datastructures.ErrBadRawData) || errors.Is(err, datastructures.ErrBadValutaCode) || errors.Is(err, ErrNoCacheFilter):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func apiErrHandler(err error, w *http.ResponseWriter) {
-	var errMessage string
 	if err != nil {
 		W := *w
-		if errors.Is(err, datastructures.ErrBadInputDateData) || errors.Is(err, datastructures.ErrBadRawData) {
-			errMessage = helpers.StringBuild(http.StatusText(http.StatusBadRequest), " (", err.Error(), ")")
-			http.Error(W, errMessage, http.StatusBadRequest)
-			W.Header().Add("Status", "400")
-		} else {
-			errMessage = helpers.StringBuild(http.StatusText(http.StatusInternalServerError), " (", err.Error(), ")")
-			http.Error(W, errMessage, http.StatusInternalServerError)
-			W.Header().Add("Status", "500")
-		}
+		statusCode := errStatusCode(err)
+		errMessage := helpers.StringBuild(http.StatusText(statusCode), " (", err.Error(), ")")
+		http.Error(W, errMessage, statusCode)
+		W.Header().Add("Status", strconv.Itoa(statusCode))
 		W.Header().Add("ErrCustom", err.Error())
 	}
 }
 
+// setCacheHeaders marks cached response with its age and stale response with the warning of RFC 7234.
+func setCacheHeaders(w http.ResponseWriter, cacheInfo app.ResponseCacheInfo) {
+	w.Header().Set(CacheStatusHeader, cacheInfo.Status)
+	if cacheInfo.Status == app.CacheMiss {
+		return
+	}
+	w.Header().Set("Age", strconv.Itoa(int(cacheInfo.Age.Seconds())))
+	if cacheInfo.Status == app.CacheStale {
+		w.Header().Set(CacheStaleReasonHeader, cacheInfo.StaleReason)
+		w.Header().Set("Warning", `110 - "Response is Stale"`)
+	}
+}
+
 func (s *Server) GetMethodDataWithoutCache(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
@@ -64,8 +97,13 @@ func (s *Server) GetMethodDataWithoutCache(w http.ResponseWriter, r *http.Reques
 			apiErrHandler(err, &w)
 			return
 		}
-		rawBody := helpers.ClearStringByWhitespaceAndLinebreak(string(body))
-		s.app.RemoveDataInMemCacheBySOAPAction(SOAPAction + rawBody)
+		// client can not evict data of the method, which it is not permitted to call
+		ctx := app.WithAPIKey(r.Context(), r.Header.Get(APIKeyHeader))
+		err = s.app.RemoveMethodDataInCache(ctx, SOAPAction, body)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
 
 		// 307, not 303: on 307 no lost body and no change verb to GET
 		http.Redirect(w, r, "/"+SOAPAction, http.StatusTemporaryRedirect)
@@ -76,18 +114,41 @@ func (s *Server) GetMethodDataWithoutCache(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-func (s *Server) universalMethodHandler(w http.ResponseWriter, r *http.Request, reqData requestData, appMethod blLayerMethod) {
+func (s *Server) CircuitBreakerStatus(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	switch r.Method {
+	case http.MethodGet:
+		status, ok := s.app.GetCircuitBreakerStatus()
+		if !ok {
+			apiErrHandler(ErrNoCircuitBreaker, &w)
+			return
+		}
+		err := s.WriteDataToOutputJSON(status, w)
+		if err != nil {
+			apiErrHandler(err, &w)
+			return
+		}
+		w.Header().Add("Status", "200")
+
+	default:
+		apiErrHandler(ErrUnsupportedMethod, &w)
+		return
+	}
+}
+
+func (s *Server) universalMethodHandler(w http.ResponseWriter, r *http.Request, reqData datastructures.RequestData, methodName string) {
 	defer r.Body.Close()
 	fullRequestTimeout, err := s.GetFullRequestTimeout()
 	if err != nil {
 		apiErrHandler(err, &w)
 		return
 	}
-	ctx, cancel := context.WithTimeout(r.Context(), fullRequestTimeout)
+	ctx, cancel := context.WithTimeout(app.WithAPIKey(r.Context(), r.Header.Get(APIKeyHeader)), fullRequestTimeout)
 	defer cancel()
 	switch r.Method {
 	case http.MethodPost:
-		body, err := s.ReadDataFromInputJSON(reqData, r)
+		_, err := s.ReadDataFromInputJSON(reqData, r)
 		if err != nil {
 			apiErrHandler(err, &w)
 			return
@@ -101,11 +162,12 @@ func (s *Server) universalMethodHandler(w http.ResponseWriter, r *http.Request,
 			return
 		}
 
-		answer, err := appMethod(ctx, reqData, body)
+		answer, cacheInfo, err := s.app.ProcessMethodWithCacheInfo(ctx, methodName, reqData)
 		if err != nil {
 			apiErrHandler(err, &w)
 			return
 		}
+		setCacheHeaders(w, cacheInfo)
 
 		err = s.WriteDataToOutputJSON(answer, w)
 		if err != nil {
@@ -122,23 +184,24 @@ func (s *Server) universalMethodHandler(w http.ResponseWriter, r *http.Request,
 }
 
 // without parameters.
-func (s *Server) universalMethodHandlerWP(w http.ResponseWriter, r *http.Request, appMethod blLayerMethodWP) {
+func (s *Server) universalMethodHandlerWP(w http.ResponseWriter, r *http.Request, methodName string) {
 	defer r.Body.Close()
 	fullRequestTimeout, err := s.GetFullRequestTimeout()
 	if err != nil {
 		apiErrHandler(err, &w)
 		return
 	}
-	ctx, cancel := context.WithTimeout(r.Context(), fullRequestTimeout)
+	ctx, cancel := context.WithTimeout(app.WithAPIKey(r.Context(), r.Header.Get(APIKeyHeader)), fullRequestTimeout)
 	defer cancel()
 	switch r.Method {
 	case http.MethodPost:
 
-		answer, err := appMethod(ctx)
+		answer, cacheInfo, err := s.app.ProcessMethodWithCacheInfo(ctx, methodName, nil)
 		if err != nil {
 			apiErrHandler(err, &w)
 			return
 		}
+		setCacheHeaders(w, cacheInfo)
 
 		err = s.WriteDataToOutputJSON(answer, w)
 		if err != nil {
@@ -154,158 +217,14 @@ func (s *Server) universalMethodHandlerWP(w http.ResponseWriter, r *http.Request
 	}
 }
 
-func (s *Server) AllDataInfoXML(w http.ResponseWriter, r *http.Request) {
-	s.universalMethodHandlerWP(w, r, s.app.AllDataInfoXML)
-}
-
-func (s *Server) GetCursOnDateXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.GetCursOnDateXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.GetCursOnDateXML)
-}
-
-func (s *Server) BiCurBaseXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.BiCurBaseXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.BiCurBaseXML)
-}
-
-func (s *Server) BliquidityXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.BliquidityXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.BliquidityXML)
-}
-
-func (s *Server) DepoDynamicXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.DepoDynamicXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.DepoDynamicXML)
-}
-
-func (s *Server) DragMetDynamicXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.DragMetDynamicXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.DragMetDynamicXML)
-}
-
-func (s *Server) DVXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.DVXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.DVXML)
-}
-
-func (s *Server) EnumReutersValutesXML(w http.ResponseWriter, r *http.Request) {
-	s.universalMethodHandlerWP(w, r, s.app.EnumReutersValutesXML)
-}
-
-func (s *Server) EnumValutesXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.EnumValutesXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.EnumValutesXML)
-}
-
-func (s *Server) KeyRateXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.KeyRateXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.KeyRateXML)
-}
-
-func (s *Server) MainInfoXML(w http.ResponseWriter, r *http.Request) {
-	s.universalMethodHandlerWP(w, r, s.app.MainInfoXML)
-}
-
-func (s *Server) Mrrf7DXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.Mrrf7DXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.Mrrf7DXML)
-}
-
-func (s *Server) MrrfXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.MrrfXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.MrrfXML)
-}
-
-func (s *Server) NewsInfoXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.NewsInfoXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.NewsInfoXML)
-}
-
-func (s *Server) OmodInfoXML(w http.ResponseWriter, r *http.Request) {
-	s.universalMethodHandlerWP(w, r, s.app.OmodInfoXML)
-}
-
-func (s *Server) OstatDepoNewXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.OstatDepoNewXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.OstatDepoNewXML)
-}
-
-func (s *Server) OstatDepoXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.OstatDepoXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.OstatDepoXML)
-}
-
-func (s *Server) OstatDynamicXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.OstatDynamicXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.OstatDynamicXML)
-}
-
-func (s *Server) OvernightXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.OvernightXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.OvernightXML)
-}
-
-func (s *Server) RepoDebtXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.Repo_debtXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.RepoDebtXML)
-}
-
-func (s *Server) RepoDebtUSDXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.RepoDebtUSDXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.RepoDebtUSDXML)
-}
-
-func (s *Server) ROISfixXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.ROISfixXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.ROISfixXML)
-}
-
-func (s *Server) RuoniaSVXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.RuoniaSVXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.RuoniaSVXML)
-}
-
-func (s *Server) RuoniaXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.RuoniaXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.RuoniaXML)
-}
-
-func (s *Server) SaldoXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SaldoXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SaldoXML)
-}
-
-func (s *Server) SwapDayTotalXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapDayTotalXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapDayTotalXML)
-}
-
-func (s *Server) SwapDynamicXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapDynamicXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapDynamicXML)
-}
-
-func (s *Server) SwapInfoSellUSDVolXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapInfoSellUSDVolXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapInfoSellUSDVolXML)
-}
-
-func (s *Server) SwapInfoSellUSDXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapInfoSellUSDXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapInfoSellUSDXML)
-}
-
-func (s *Server) SwapInfoSellVolXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapInfoSellVolXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapInfoSellVolXML)
-}
-
-func (s *Server) SwapInfoSellXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapInfoSellXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapInfoSellXML)
-}
-
-func (s *Server) SwapMonthTotalXML(w http.ResponseWriter, r *http.Request) {
-	newRequest := datastructures.SwapMonthTotalXML{}
-	s.universalMethodHandler(w, r, &newRequest, s.app.SwapMonthTotalXML)
+// methodHandler returns handler of the registered method, request structure is created for each call.
+func (s *Server) methodHandler(descriptor datastructures.MethodDescriptor) http.HandlerFunc { //nolint: gocritic
+	if descriptor.WithoutParams {
+		return func(w http.ResponseWriter, r *http.Request) {
+			s.universalMethodHandlerWP(w, r, descriptor.Name)
+		}
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.universalMethodHandler(w, r, descriptor.NewRequest(), descriptor.Name)
+	}
 }
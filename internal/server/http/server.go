@@ -10,6 +10,10 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	app "github.com/skolzkyi/cbrwsdltojson/internal/app"
+	customsoap "github.com/skolzkyi/cbrwsdltojson/internal/customsoap"
+	datastructures "github.com/skolzkyi/cbrwsdltojson/internal/datastructures"
 )
 
 var ErrAssertionGetFullRequestTimeout = errors.New("error of data assertion on get full request timeout")
@@ -33,9 +37,10 @@ type Config interface {
 	GetCBRWSDLTimeout() time.Duration
 	GetInfoExpirTime() time.Duration
 	GetInfoClearTimeDelta() time.Duration
+	GetLatestDateCheckInterval() time.Duration
 	GetCBRWSDLAddress() string
 	GetLoggingOn() bool
-	GetPermittedRequests() map[string]struct{}
+	GetAdminToken() string
 }
 
 type Logger interface {
@@ -47,41 +52,16 @@ type Logger interface {
 }
 
 type Application interface {
-	RemoveDataInMemCacheBySOAPAction(SOAPAction string)
-	StartCacheCleaner(ctx context.Context)
-
-	AllDataInfoXML(ctx context.Context) (interface{}, error)
-	GetCursOnDateXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	BiCurBaseXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	BliquidityXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	DepoDynamicXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	DragMetDynamicXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	DVXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	EnumReutersValutesXML(ctx context.Context) (interface{}, error)
-	EnumValutesXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	KeyRateXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	MainInfoXML(ctx context.Context) (interface{}, error)
-	Mrrf7DXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	MrrfXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	NewsInfoXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	OmodInfoXML(ctx context.Context) (interface{}, error)
-	OstatDepoNewXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	OstatDepoXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	OstatDynamicXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	OvernightXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	RepoDebtXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	RepoDebtUSDXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	ROISfixXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	RuoniaSVXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	RuoniaXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SaldoXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapDayTotalXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapDynamicXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapInfoSellUSDVolXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapInfoSellUSDXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapInfoSellVolXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapInfoSellXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
-	SwapMonthTotalXML(ctx context.Context, input interface{}, rawBody string) (interface{}, error)
+	RemoveMethodDataInCache(ctx context.Context, methodName string, rawBody []byte) error
+	GetMethodDescriptors() []datastructures.MethodDescriptor
+	ProcessMethodWithCacheInfo(ctx context.Context, methodName string, input interface{}) (interface{}, app.ResponseCacheInfo, error)
+	GetCircuitBreakerStatus() (customsoap.CircuitBreakerStatus, bool)
+	GetCacheEntries(filter app.CacheFilter) ([]app.CacheEntry, error)
+	GetCacheEntry(key string) (app.CacheEntry, error)
+	EvictCacheEntries(filter app.CacheFilter) (int, error)
+	FlushCache() int
+	GetCacheStats() app.CacheStats
+	ExportCacheSnapshot(w io.Writer, compress bool) (int, error)
 }
 
 func NewServer(logger Logger, app Application, config Config) *Server {
@@ -116,7 +96,6 @@ func (s *Server) Start(ctx context.Context) error {
 			}
 		}
 	}()
-	s.app.StartCacheCleaner(ctx)
 	s.logg.Info("metrics server is running...")
 	err := s.metricsServ.ListenAndServe()
 	if err != nil {
@@ -0,0 +1,146 @@
+package warmup
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleLookahead limits search of the next run, schedule without runs in it (e.g. "0 0 30 2 *") is invalid.
+const maxScheduleLookahead = 5 * 366 * 24 * time.Hour
+
+var ErrBadSchedule = errors.New("bad schedule, expected cron expression \"minute hour day-of-month month day-of-week\"")
+
+// Schedule is the cron expression of five fields: minute, hour, day of month, month and day of week (0 and 7 are Sunday).
+// Field is "*", a value, a range "a-b", a list "a,b" or any of them with the step "/n". As in cron, if both days of month
+// and of week are restricted, the day matches any of them.
+type Schedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+	// anyDay is true, if days of month or days of week are not restricted
+	anyDay   bool
+	location *time.Location
+}
+
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// ParseSchedule parses the cron expression, times of runs are in the location.
+func ParseSchedule(expression string, location *time.Location) (*Schedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(scheduleFields) {
+		return nil, fmt.Errorf("%w: %q", ErrBadSchedule, expression)
+	}
+	sets := make([]uint64, len(fields))
+	for i, field := range fields {
+		set, err := parseScheduleField(field, scheduleFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s", ErrBadSchedule, expression, err.Error())
+		}
+		sets[i] = set
+	}
+	schedule := &Schedule{
+		minutes:     sets[0],
+		hours:       sets[1],
+		daysOfMonth: sets[2],
+		months:      sets[3],
+		// Sunday is both 0 and 7
+		daysOfWeek: sets[4] | sets[4]>>7,
+		anyDay:     fields[2] == "*" || fields[4] == "*",
+		location:   location,
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("%w: %q never runs", ErrBadSchedule, expression)
+	}
+	return schedule, nil
+}
+
+// parseScheduleField returns the set of values of the field as bits.
+func parseScheduleField(field string, limits scheduleField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("bad step of %s: %q", limits.name, item)
+			}
+		}
+		first, last := limits.min, limits.max
+		if rangePart != "*" {
+			firstPart, lastPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			first, err = strconv.Atoi(firstPart)
+			if err != nil {
+				return 0, fmt.Errorf("bad %s: %q", limits.name, item)
+			}
+			last = first
+			if isRange {
+				last, err = strconv.Atoi(lastPart)
+				if err != nil {
+					return 0, fmt.Errorf("bad %s: %q", limits.name, item)
+				}
+			} else if hasStep {
+				last = limits.max
+			}
+		}
+		if first < limits.min || last > limits.max || first > last {
+			return 0, fmt.Errorf("%s out of range %d-%d: %q", limits.name, limits.min, limits.max, item)
+		}
+		for value := first; value <= last; value += step {
+			set |= 1 << uint(value)
+		}
+	}
+	return set, nil
+}
+
+func hasValue(set uint64, value int) bool {
+	return set&(1<<uint(value)) != 0
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := hasValue(s.daysOfMonth, t.Day())
+	dayOfWeek := hasValue(s.daysOfWeek, int(t.Weekday()))
+	if s.anyDay {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// Next returns the first run after the time, zero time if there is no run in the lookahead.
+func (s *Schedule) Next(after time.Time) time.Time {
+	t := after.In(s.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxScheduleLookahead)
+	for t.Before(limit) {
+		switch {
+		case !hasValue(s.months, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+		case !hasValue(s.hours, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+		case !hasValue(s.minutes, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
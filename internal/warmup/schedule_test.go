@@ -0,0 +1,95 @@
+package warmup_test
+
+import (
+	"testing"
+	"time"
+
+	warmup "github.com/skolzkyi/cbrwsdltojson/internal/warmup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		next       time.Time
+		// location of the schedule is Moscow, if it is nil
+		location *time.Location
+	}{
+		{
+			name:       "weekdays, later today",
+			expression: "30 15 * * 1-5",
+			after:      time.Date(2023, 6, 22, 10, 0, 0, 0, moscow),
+			next:       time.Date(2023, 6, 22, 15, 30, 0, 0, moscow),
+		},
+		{
+			name:       "weekdays, after run on Friday",
+			expression: "30 15 * * 1-5",
+			after:      time.Date(2023, 6, 23, 15, 30, 0, 0, moscow),
+			next:       time.Date(2023, 6, 26, 15, 30, 0, 0, moscow),
+		},
+		{
+			name:       "time in other location",
+			expression: "30 15 * * 1-5",
+			after:      time.Date(2023, 6, 22, 12, 29, 59, 0, time.UTC),
+			next:       time.Date(2023, 6, 22, 15, 30, 0, 0, moscow),
+		},
+		{
+			name:       "steps and lists",
+			expression: "*/20 9,18 * * *",
+			after:      time.Date(2023, 6, 22, 9, 40, 0, 0, moscow),
+			next:       time.Date(2023, 6, 22, 18, 0, 0, 0, moscow),
+		},
+		{
+			name:       "Sunday as 7",
+			expression: "0 0 * * 7",
+			after:      time.Date(2023, 6, 22, 0, 0, 0, 0, moscow),
+			next:       time.Date(2023, 6, 25, 0, 0, 0, 0, moscow),
+		},
+		{
+			name:       "day of month or day of week",
+			expression: "0 12 1 * 6",
+			after:      time.Date(2023, 6, 25, 0, 0, 0, 0, moscow),
+			next:       time.Date(2023, 7, 1, 12, 0, 0, 0, moscow),
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 2 *",
+			after:      time.Date(2023, 3, 1, 0, 0, 0, 0, moscow),
+			next:       time.Date(2024, 2, 29, 0, 0, 0, 0, moscow),
+		},
+		{
+			name:       "location with half-hour offset",
+			expression: "0 9,18 * * *",
+			after:      time.Date(2023, 6, 22, 9, 30, 0, 0, kolkata),
+			next:       time.Date(2023, 6, 22, 18, 0, 0, 0, kolkata),
+			location:   kolkata,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run("TestSchedule: "+tc.name, func(t *testing.T) {
+			t.Parallel()
+			location := moscow
+			if tc.location != nil {
+				location = tc.location
+			}
+			schedule, err := warmup.ParseSchedule(tc.expression, location)
+			require.NoError(t, err)
+			next := schedule.Next(tc.after)
+			require.True(t, tc.next.Equal(next), next.String())
+		})
+	}
+
+	for _, expression := range []string{"", "30 15 * *", "60 15 * * *", "30 15 * * 8", "30 15 0 * *", "30 15 * * 5-1", "*/0 * * * *", "a * * * *", "0 0 30 2 *"} {
+		_, err := warmup.ParseSchedule(expression, moscow)
+		require.ErrorIs(t, err, warmup.ErrBadSchedule, expression)
+	}
+}
@@ -0,0 +1,177 @@
+package warmup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// dateLayout is the layout of dates in requests of CBR WS methods.
+const dateLayout = "2006-01-02"
+
+// datePlaceholder is "{today}" or "{today-30d}", "{today+1d}" in requests of jobs, it is replaced by the date of the run.
+var datePlaceholder = regexp.MustCompile(`\{today(?:([+-]\d+)d)?\}`)
+
+type Logger interface {
+	Info(msg string)
+	Warning(msg string)
+	Error(msg string)
+	Fatal(msg string)
+	GetZapLogger() *zap.SugaredLogger
+}
+
+// Refresher requests CBR WS and refreshes cached data of the method request, raw body is JSON of the request as in the method handler.
+type Refresher interface {
+	ValidateWarmUp(methodName string, rawBody []byte) error
+	WarmUp(ctx context.Context, methodName string, rawBody []byte) error
+}
+
+// Job is the method request, which is refreshed by the schedule.
+type Job struct {
+	Schedule string          `json:"schedule"`
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+}
+
+type Metrics struct {
+	Runs *prometheus.CounterVec
+}
+
+func CreateMetrics() Metrics {
+	return Metrics{
+		Runs: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cbrwsdltojson",
+			Subsystem: "warmup",
+			Name:      "runs_total",
+		}, []string{"method", "result"}),
+	}
+}
+
+// LoadJobs reads the JSON list of jobs, void file path means no jobs.
+func LoadJobs(filePath string) ([]Job, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []Job
+	err = json.Unmarshal(data, &jobs)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// RequestOn returns the request of the job with date placeholders replaced by dates relative to the day of the time.
+func (j *Job) RequestOn(t time.Time) []byte {
+	if len(j.Request) == 0 {
+		return []byte("{}")
+	}
+	return datePlaceholder.ReplaceAllFunc(j.Request, func(placeholder []byte) []byte {
+		days := 0
+		offset := datePlaceholder.FindSubmatch(placeholder)[1]
+		if len(offset) > 0 {
+			days, _ = strconv.Atoi(string(offset))
+		}
+		return []byte(t.AddDate(0, 0, days).Format(dateLayout))
+	})
+}
+
+type scheduledJob struct {
+	Job
+	schedule *Schedule
+}
+
+// Scheduler refreshes cached data of jobs by their schedules, runs of every job are sequential.
+type Scheduler struct {
+	logger    Logger
+	refresher Refresher
+	jobs      []scheduledJob
+	location  *time.Location
+	timeout   time.Duration
+	metrics   Metrics
+	now       func() time.Time
+}
+
+// NewScheduler checks schedules, methods and requests of all jobs, schedules are in the location.
+// Timeout limits every run of a job.
+func NewScheduler(logger Logger, refresher Refresher, jobs []Job, location *time.Location, timeout time.Duration, metrics Metrics) (*Scheduler, error) {
+	scheduler := &Scheduler{
+		logger:    logger,
+		refresher: refresher,
+		jobs:      make([]scheduledJob, 0, len(jobs)),
+		location:  location,
+		timeout:   timeout,
+		metrics:   metrics,
+		now:       time.Now,
+	}
+	for i, job := range jobs {
+		schedule, err := ParseSchedule(job.Schedule, location)
+		if err != nil {
+			return nil, fmt.Errorf("warm-up job %d (%s): %w", i, job.Method, err)
+		}
+		err = refresher.ValidateWarmUp(job.Method, job.RequestOn(scheduler.now().In(location)))
+		if err != nil {
+			return nil, fmt.Errorf("warm-up job %d (%s): %w", i, job.Method, err)
+		}
+		scheduler.jobs = append(scheduler.jobs, scheduledJob{Job: job, schedule: schedule})
+	}
+	return scheduler, nil
+}
+
+// Start runs jobs by their schedules until the context is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	for i := range s.jobs {
+		go s.runJob(ctx, &s.jobs[i])
+	}
+	s.logger.Info(fmt.Sprintf("warm-up scheduler: %d jobs started", len(s.jobs)))
+}
+
+func (s *Scheduler) runJob(ctx context.Context, job *scheduledJob) {
+	for {
+		next := job.schedule.Next(s.now())
+		if next.IsZero() {
+			return
+		}
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		_ = s.Run(ctx, job.Job, next)
+	}
+}
+
+// Run refreshes cached data of the job request on the time of the run.
+func (s *Scheduler) Run(ctx context.Context, job Job, at time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	err := s.refresher.WarmUp(ctx, job.Method, job.RequestOn(at.In(s.location)))
+	if err != nil {
+		s.logger.Error("warm-up of " + job.Method + " error: " + err.Error())
+		s.observeRun(job.Method, "error")
+		return err
+	}
+	s.logger.Info("warm-up of " + job.Method + " done")
+	s.observeRun(job.Method, "success")
+	return nil
+}
+
+func (s *Scheduler) observeRun(method string, result string) {
+	if s.metrics.Runs == nil {
+		return
+	}
+	s.metrics.Runs.With(prometheus.Labels{"method": method, "result": result}).Add(1)
+}
@@ -0,0 +1,90 @@
+package warmup_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	mocks "github.com/skolzkyi/cbrwsdltojson/internal/mocks"
+	warmup "github.com/skolzkyi/cbrwsdltojson/internal/warmup"
+	"github.com/stretchr/testify/require"
+)
+
+var errRefresh = errors.New("refresh error")
+
+type refresherMock struct {
+	mu       sync.Mutex
+	err      error
+	requests []string
+}
+
+func (rm *refresherMock) ValidateWarmUp(methodName string, _ []byte) error {
+	if methodName != "KeyRateXML" {
+		return errRefresh
+	}
+	return nil
+}
+
+func (rm *refresherMock) WarmUp(ctx context.Context, _ string, rawBody []byte) error {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	_, ok := ctx.Deadline()
+	if !ok {
+		return errors.New("no timeout")
+	}
+	rm.requests = append(rm.requests, string(rawBody))
+	return rm.err
+}
+
+func TestLoadJobs(t *testing.T) {
+	t.Parallel()
+	jobs, err := warmup.LoadJobs("")
+	require.NoError(t, err)
+	require.Len(t, jobs, 0)
+
+	filePath := filepath.Join(t.TempDir(), "warmup.json")
+	err = os.WriteFile(filePath, []byte(`[{"schedule":"30 15 * * 1-5","method":"KeyRateXML","request":{"FromDate":"{today-30d}","ToDate":"{today}"}}]`), 0o600)
+	require.NoError(t, err)
+	jobs, err = warmup.LoadJobs(filePath)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "30 15 * * 1-5", jobs[0].Schedule)
+	require.Equal(t, "KeyRateXML", jobs[0].Method)
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+	loggerMock, err := mocks.NewLoggerMock(false)
+	require.NoError(t, err)
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	job := warmup.Job{
+		Schedule: "30 15 * * 1-5",
+		Method:   "KeyRateXML",
+		Request:  json.RawMessage(`{"FromDate":"{today-30d}","ToDate":"{today}","Tomorrow":"{today+1d}"}`),
+	}
+
+	refresher := &refresherMock{}
+	_, err = warmup.NewScheduler(loggerMock, refresher, []warmup.Job{{Schedule: "30 15 * *", Method: "KeyRateXML"}}, moscow, time.Second, warmup.Metrics{})
+	require.ErrorIs(t, err, warmup.ErrBadSchedule)
+	_, err = warmup.NewScheduler(loggerMock, refresher, []warmup.Job{{Schedule: "30 15 * * *", Method: "UnknownMethod"}}, moscow, time.Second, warmup.Metrics{})
+	require.ErrorIs(t, err, errRefresh)
+
+	scheduler, err := warmup.NewScheduler(loggerMock, refresher, []warmup.Job{job}, moscow, time.Second, warmup.Metrics{})
+	require.NoError(t, err)
+	// dates are in the location of schedules: 2023-06-22 21:30 UTC is 2023-06-23 in Moscow
+	err = scheduler.Run(context.Background(), job, time.Date(2023, 6, 22, 21, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"FromDate":"2023-05-24","ToDate":"2023-06-23","Tomorrow":"2023-06-24"}`}, refresher.requests)
+
+	refresher.err = errRefresh
+	err = scheduler.Run(context.Background(), job, time.Date(2023, 6, 22, 12, 30, 0, 0, time.UTC))
+	require.ErrorIs(t, err, errRefresh)
+
+	require.Equal(t, "{}", string((&warmup.Job{Method: "MainInfoXML"}).RequestOn(time.Now())))
+}